@@ -0,0 +1,65 @@
+package clock
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestReal(t *testing.T) {
+	c := Real()
+	before := time.Now()
+	require.False(t, c.Now().Before(before))
+
+	timer := c.NewTimer(time.Millisecond)
+	<-timer.C()
+	require.False(t, timer.Stop())
+}
+
+func TestFake(t *testing.T) {
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	c := NewFake(start)
+	require.Equal(t, start, c.Now())
+
+	t1 := c.NewTimer(10 * time.Millisecond)
+	t2 := c.NewTimer(20 * time.Millisecond)
+	c.BlockUntil(2)
+
+	c.Advance(5 * time.Millisecond)
+	require.Equal(t, start.Add(5*time.Millisecond), c.Now())
+	requireNotFired(t, t1)
+
+	c.Advance(5 * time.Millisecond)
+	require.Equal(t, start.Add(10*time.Millisecond), <-t1.C())
+	requireNotFired(t, t2)
+	require.False(t, t1.Stop())
+
+	require.True(t, t2.Stop())
+	c.Advance(time.Second)
+	requireNotFired(t, t2)
+}
+
+func TestFake_Immediate(t *testing.T) {
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	c := NewFake(start)
+	require.Equal(t, start, <-c.NewTimer(0).C())
+}
+
+func TestFake_BlockUntil(t *testing.T) {
+	c := NewFake(time.Time{})
+	done := make(chan struct{})
+	go func() {
+		c.BlockUntil(1)
+		close(done)
+	}()
+	c.NewTimer(time.Second)
+	<-done
+}
+
+func requireNotFired(t *testing.T, timer Timer) {
+	select {
+	case <-timer.C():
+		require.Fail(t, "timer fired")
+	default:
+	}
+}
@@ -0,0 +1,86 @@
+package either
+
+import (
+	"fmt"
+	"github.com/sergeychunayev/gofu/pkg/option"
+)
+
+// Either holds a value of one of two types: L (Left) or R (Right). The zero
+// value is Left with the zero value of L.
+type Either[L any, R any] struct {
+	l     L
+	r     R
+	right bool
+}
+
+func Left[L any, R any](v L) Either[L, R] {
+	return Either[L, R]{l: v}
+}
+
+func Right[L any, R any](v R) Either[L, R] {
+	return Either[L, R]{r: v, right: true}
+}
+
+func (e Either[L, R]) IsLeft() bool {
+	return !e.right
+}
+
+func (e Either[L, R]) IsRight() bool {
+	return e.right
+}
+
+// Left returns the Left value of e and whether e is Left.
+func (e Either[L, R]) Left() (L, bool) {
+	return e.l, !e.right
+}
+
+// Right returns the Right value of e and whether e is Right.
+func (e Either[L, R]) Right() (R, bool) {
+	return e.r, e.right
+}
+
+// Swap turns a Left into a Right and vice versa.
+func (e Either[L, R]) Swap() Either[R, L] {
+	return Either[R, L]{l: e.r, r: e.l, right: !e.right}
+}
+
+func (e Either[L, R]) String() string {
+	if e.right {
+		return fmt.Sprintf("Right(%v)", e.r)
+	}
+	return fmt.Sprintf("Left(%v)", e.l)
+}
+
+// Fold applies onLeft or onRight depending on which value e holds.
+func Fold[L any, R any, T any](e Either[L, R], onLeft func(v L) T, onRight func(v R) T) T {
+	if e.right {
+		return onRight(e.r)
+	}
+	return onLeft(e.l)
+}
+
+// MapLeft applies f to the Left value of e.
+func MapLeft[L any, R any, T any](e Either[L, R], f func(v L) T) Either[T, R] {
+	if e.right {
+		return Right[T](e.r)
+	}
+	return Left[T, R](f(e.l))
+}
+
+// MapRight applies f to the Right value of e.
+func MapRight[L any, R any, T any](e Either[L, R], f func(v R) T) Either[L, T] {
+	if e.right {
+		return Right[L](f(e.r))
+	}
+	return Left[L, T](e.l)
+}
+
+// LeftOption returns the Left value of e as Some.
+func LeftOption[L any, R any](e Either[L, R]) option.Option[L] {
+	return option.FromPair(e.Left())
+}
+
+// RightOption returns the Right value of e as Some.
+func RightOption[L any, R any](e Either[L, R]) option.Option[R] {
+	return option.FromPair(e.Right())
+}
@@ -0,0 +1,89 @@
+package either
+
+import (
+	"github.com/sergeychunayev/gofu/pkg/iterable"
+	"github.com/sergeychunayev/gofu/pkg/option"
+	"github.com/stretchr/testify/require"
+	"strconv"
+	"testing"
+)
+
+func TestEither(t *testing.T) {
+	l := Left[int, string](1)
+	require.True(t, l.IsLeft())
+	require.False(t, l.IsRight())
+	v, ok := l.Left()
+	require.True(t, ok)
+	require.Equal(t, 1, v)
+	_, ok = l.Right()
+	require.False(t, ok)
+	require.Equal(t, "Left(1)", l.String())
+
+	r := Right[int]("a")
+	require.False(t, r.IsLeft())
+	require.True(t, r.IsRight())
+	s, ok := r.Right()
+	require.True(t, ok)
+	require.Equal(t, "a", s)
+	require.Equal(t, "Right(a)", r.String())
+}
+
+func TestEither_Zero(t *testing.T) {
+	var e Either[int, string]
+	require.True(t, e.IsLeft())
+	require.Equal(t, Left[int, string](0), e)
+}
+
+func TestSwap(t *testing.T) {
+	require.Equal(t, Right[string](1), Left[int, string](1).Swap())
+	require.Equal(t, Left[string, int]("a"), Right[int]("a").Swap())
+	require.Equal(t, Left[int, string](1), Left[int, string](1).Swap().Swap())
+}
+
+func TestFold(t *testing.T) {
+	length := func(v string) int {
+		return len(v)
+	}
+	neg := func(v int) int {
+		return -v
+	}
+	require.Equal(t, -2, Fold(Left[int, string](2), neg, length))
+	require.Equal(t, 3, Fold(Right[int]("abc"), neg, length))
+}
+
+func TestMapLeft(t *testing.T) {
+	require.Equal(t, Left[string, int]("1"), MapLeft(Left[int, int](1), strconv.Itoa))
+	require.Equal(t, Right[string](1), MapLeft(Right[int](1), strconv.Itoa))
+}
+
+func TestMapRight(t *testing.T) {
+	require.Equal(t, Right[int]("1"), MapRight(Right[int](1), strconv.Itoa))
+	require.Equal(t, Left[int, string](1), MapRight(Left[int, int](1), strconv.Itoa))
+}
+
+func TestOption(t *testing.T) {
+	require.Equal(t, option.Of(1), LeftOption(Left[int, string](1)))
+	require.Equal(t, option.No[string](), RightOption(Left[int, string](1)))
+	require.Equal(t, option.No[int](), LeftOption(Right[int]("a")))
+	require.Equal(t, option.Of("a"), RightOption(Right[int]("a")))
+}
+
+func TestPartition(t *testing.T) {
+	testCases := []struct {
+		name   string
+		input  []Either[int, string]
+		lefts  []int
+		rights []string
+	}{
+		{"Empty", nil, nil, nil},
+		{"Lefts", []Either[int, string]{Left[int, string](1), Left[int, string](2)}, []int{1, 2}, nil},
+		{"Mixed", []Either[int, string]{Left[int, string](1), Right[int]("a"), Left[int, string](2)}, []int{1, 2}, []string{"a"}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			lefts, rights := Partition(iterable.New(tc.input))
+			require.Equal(t, tc.lefts, lefts)
+			require.Equal(t, tc.rights, rights)
+		})
+	}
+}
@@ -0,0 +1,31 @@
+package either_test
+
+import (
+	"fmt"
+	"github.com/sergeychunayev/gofu/pkg/either"
+	"github.com/sergeychunayev/gofu/pkg/iterable"
+	"strconv"
+)
+
+func ExamplePartition() {
+	parse := func(s string) either.Either[string, int] {
+		v, err := strconv.Atoi(s)
+		if err != nil {
+			return either.Left[string, int](s)
+		}
+		return either.Right[string](v)
+	}
+	invalid, valid := either.Partition(iterable.Map(iterable.New([]string{"1", "x", "3"}), parse))
+	fmt.Println(invalid, valid)
+	// Output: [x] [1 3]
+}
+
+func ExampleFold() {
+	e := either.Right[error]("cached")
+	fmt.Println(either.Fold(e, func(err error) string {
+		return "miss: " + err.Error()
+	}, func(v string) string {
+		return "hit: " + v
+	}))
+	// Output: hit: cached
+}
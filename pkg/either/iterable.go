@@ -0,0 +1,17 @@
+package either
+
+import "github.com/sergeychunayev/gofu/pkg/iterable"
+
+// Partition splits the elements of it into Left and Right values.
+func Partition[L any, R any](it iterable.Iterable[Either[L, R]]) ([]L, []R) {
+	var lefts []L
+	var rights []R
+	for e, ok := it.TryNext(); ok; e, ok = it.TryNext() {
+		if e.right {
+			rights = append(rights, e.r)
+			continue
+		}
+		lefts = append(lefts, e.l)
+	}
+	return lefts, rights
+}
@@ -0,0 +1,67 @@
+package fn
+
+// Compose returns a function that applies g and then f: Compose(f, g)(v)
+// is f(g(v)).
+func Compose[A any, B any, C any](f func(v B) C, g func(v A) B) func(v A) C {
+	return func(v A) C {
+		return f(g(v))
+	}
+}
+
+// Compose3 returns a function that applies h, g and then f.
+func Compose3[A any, B any, C any, D any](f func(v C) D, g func(v B) C, h func(v A) B) func(v A) D {
+	return func(v A) D {
+		return f(g(h(v)))
+	}
+}
+
+// Compose4 returns a function that applies i, h, g and then f.
+func Compose4[A any, B any, C any, D any, E any](f func(v D) E, g func(v C) D, h func(v B) C, i func(v A) B) func(v A) E {
+	return func(v A) E {
+		return f(g(h(i(v))))
+	}
+}
+
+// Pipe returns a function that applies f and then g: Pipe(f, g)(v) is
+// g(f(v)).
+func Pipe[A any, B any, C any](f func(v A) B, g func(v B) C) func(v A) C {
+	return func(v A) C {
+		return g(f(v))
+	}
+}
+
+// Pipe3 returns a function that applies f, g and then h.
+func Pipe3[A any, B any, C any, D any](f func(v A) B, g func(v B) C, h func(v C) D) func(v A) D {
+	return func(v A) D {
+		return h(g(f(v)))
+	}
+}
+
+// Pipe4 returns a function that applies f, g, h and then i.
+func Pipe4[A any, B any, C any, D any, E any](f func(v A) B, g func(v B) C, h func(v C) D, i func(v D) E) func(v A) E {
+	return func(v A) E {
+		return i(h(g(f(v))))
+	}
+}
+
+// Chain returns a function that applies any number of functions of the same
+// type in order. Chain() is Identity.
+func Chain[T any](fs ...func(v T) T) func(v T) T {
+	return func(v T) T {
+		for _, f := range fs {
+			v = f(v)
+		}
+		return v
+	}
+}
+
+func Identity[T any](v T) T {
+	return v
+}
+
+// Const returns a function that ignores its argument and returns v.
+func Const[A any, T any](v T) func(a A) T {
+	return func(A) T {
+		return v
+	}
+}
@@ -0,0 +1,59 @@
+package fn
+
+func Curry[A any, B any, C any](f func(a A, b B) C) func(a A) func(b B) C {
+	return func(a A) func(b B) C {
+		return func(b B) C {
+			return f(a, b)
+		}
+	}
+}
+
+func Curry3[A any, B any, C any, D any](f func(a A, b B, c C) D) func(a A) func(b B) func(c C) D {
+	return func(a A) func(b B) func(c C) D {
+		return func(b B) func(c C) D {
+			return func(c C) D {
+				return f(a, b, c)
+			}
+		}
+	}
+}
+
+func Uncurry[A any, B any, C any](f func(a A) func(b B) C) func(a A, b B) C {
+	return func(a A, b B) C {
+		return f(a)(b)
+	}
+}
+
+func Uncurry3[A any, B any, C any, D any](f func(a A) func(b B) func(c C) D) func(a A, b B, c C) D {
+	return func(a A, b B, c C) D {
+		return f(a)(b)(c)
+	}
+}
+
+// Partial fixes the first argument of f.
+func Partial[A any, B any, C any](f func(a A, b B) C, a A) func(b B) C {
+	return func(b B) C {
+		return f(a, b)
+	}
+}
+
+// PartialRight fixes the last argument of f.
+func PartialRight[A any, B any, C any](f func(a A, b B) C, b B) func(a A) C {
+	return func(a A) C {
+		return f(a, b)
+	}
+}
+
+// Partial3 fixes the first argument of f.
+func Partial3[A any, B any, C any, D any](f func(a A, b B, c C) D, a A) func(b B, c C) D {
+	return func(b B, c C) D {
+		return f(a, b, c)
+	}
+}
+
+// Flip swaps the arguments of f.
+func Flip[A any, B any, C any](f func(a A, b B) C) func(b B, a A) C {
+	return func(b B, a A) C {
+		return f(a, b)
+	}
+}
@@ -0,0 +1,36 @@
+package fn_test
+
+import (
+	"fmt"
+	"github.com/sergeychunayev/gofu/pkg/fn"
+	"github.com/sergeychunayev/gofu/pkg/iterable"
+	"strconv"
+	"strings"
+)
+
+func ExamplePipe() {
+	res := iterable.Map(iterable.New([]int{1, 2, 3}), fn.Pipe(strconv.Itoa, fn.PartialRight(strings.Repeat, 2)))
+	fmt.Println(res.ToSlice())
+	// Output: [11 22 33]
+}
+
+func ExampleTupled() {
+	add := func(a, b int) int {
+		return a + b
+	}
+	res := iterable.Map(iterable.Zip(iterable.New([]int{1, 2}), iterable.New([]int{10, 20})), fn.Tupled(add))
+	fmt.Println(res.ToSlice())
+	// Output: [11 22]
+}
+
+func ExampleAnd() {
+	even := func(v int) bool {
+		return v%2 == 0
+	}
+	big := func(v int) bool {
+		return v > 2
+	}
+	res := iterable.New([]int{1, 2, 3, 4}).Filter(fn.And(even, fn.Not(big))).ToSlice()
+	fmt.Println(res)
+	// Output: [2]
+}
@@ -0,0 +1,105 @@
+package fn
+
+import (
+	"github.com/sergeychunayev/gofu/pkg/iterable"
+	"github.com/stretchr/testify/require"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func double(v int) int {
+	return v * 2
+}
+
+func inc(v int) int {
+	return v + 1
+}
+
+func isEven(v int) bool {
+	return v%2 == 0
+}
+
+func isPositive(v int) bool {
+	return v > 0
+}
+
+func sub(a, b int) int {
+	return a - b
+}
+
+func TestCompose(t *testing.T) {
+	require.Equal(t, "4", Compose(strconv.Itoa, double)(2))
+	require.Equal(t, "5", Compose3(strconv.Itoa, inc, double)(2))
+	require.Equal(t, "7", Compose4(strconv.Itoa, inc, double, func(s string) int {
+		return len(s)
+	})("abc"))
+}
+
+func TestPipe(t *testing.T) {
+	require.Equal(t, "3", Pipe(inc, strconv.Itoa)(2))
+	require.Equal(t, "6", Pipe3(inc, double, strconv.Itoa)(2))
+	require.Equal(t, 1, Pipe4(inc, double, strconv.Itoa, func(s string) int {
+		return len(s)
+	})(2))
+}
+
+func TestChain(t *testing.T) {
+	require.Equal(t, 6, Chain(inc, double)(2))
+	require.Equal(t, 5, Chain(double, inc)(2))
+	require.Equal(t, 2, Chain[int]()(2))
+}
+
+func TestIdentityConst(t *testing.T) {
+	require.Equal(t, 1, Identity(1))
+	require.Equal(t, "a", Const[int]("a")(1))
+}
+
+func TestCurry(t *testing.T) {
+	require.Equal(t, 1, Curry(sub)(3)(2))
+	require.Equal(t, 1, Uncurry(Curry(sub))(3, 2))
+
+	f := func(a, b, c int) int {
+		return a*100 + b*10 + c
+	}
+	require.Equal(t, 123, Curry3(f)(1)(2)(3))
+	require.Equal(t, 123, Uncurry3(Curry3(f))(1, 2, 3))
+}
+
+func TestPartial(t *testing.T) {
+	require.Equal(t, 7, Partial(sub, 10)(3))
+	require.Equal(t, 7, PartialRight(sub, 3)(10))
+	require.Equal(t, "a-b", Partial3(strings.ReplaceAll, "a b")(" ", "-"))
+}
+
+func TestFlip(t *testing.T) {
+	require.Equal(t, -1, Flip(sub)(3, 2))
+}
+
+func TestPredicates(t *testing.T) {
+	testCases := []struct {
+		name     string
+		f        func(v int) bool
+		expected []int
+	}{
+		{"Not", Not(isEven), []int{-1, 1}},
+		{"And", And(isEven, isPositive), []int{2}},
+		{"And empty", And[int](), []int{-1, 0, 1, 2}},
+		{"Or", Or(isEven, isPositive), []int{0, 1, 2}},
+		{"Or empty", Or[int](), nil},
+		{"Xor", Xor(isEven, isPositive), []int{0, 1}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res := iterable.New([]int{-1, 0, 1, 2}).Filter(tc.f).ToSlice()
+			require.Equal(t, tc.expected, res)
+		})
+	}
+}
+
+func TestTupled(t *testing.T) {
+	res := iterable.Map(iterable.Zip(iterable.New([]int{5, 6}), iterable.New([]int{1, 2})), Tupled(sub)).ToSlice()
+	require.Equal(t, []int{4, 4}, res)
+	require.Equal(t, 1, Untupled(Tupled(sub))(3, 2))
+	require.Equal(t, iterable.Tuple[int, string]{A: 1, B: "a"}, Pair(1, "a"))
+}
@@ -0,0 +1,40 @@
+package fn
+
+func Not[T any](f func(v T) bool) func(v T) bool {
+	return func(v T) bool {
+		return !f(v)
+	}
+}
+
+// And returns a predicate that holds when all of fs hold. It stops at the
+// first one that does not; And() always holds.
+func And[T any](fs ...func(v T) bool) func(v T) bool {
+	return func(v T) bool {
+		for _, f := range fs {
+			if !f(v) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// Or returns a predicate that holds when any of fs holds. It stops at the
+// first one that does; Or() never holds.
+func Or[T any](fs ...func(v T) bool) func(v T) bool {
+	return func(v T) bool {
+		for _, f := range fs {
+			if f(v) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// Xor returns a predicate that holds when exactly one of f and g holds.
+func Xor[T any](f func(v T) bool, g func(v T) bool) func(v T) bool {
+	return func(v T) bool {
+		return f(v) != g(v)
+	}
+}
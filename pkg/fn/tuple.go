@@ -0,0 +1,22 @@
+package fn
+
+import "github.com/sergeychunayev/gofu/pkg/iterable"
+
+// Tupled adapts f to take a Tuple, such as the elements of iterable.Zip.
+func Tupled[A any, B any, C any](f func(a A, b B) C) func(t iterable.Tuple[A, B]) C {
+	return func(t iterable.Tuple[A, B]) C {
+		return f(t.A, t.B)
+	}
+}
+
+// Untupled is the inverse of Tupled.
+func Untupled[A any, B any, C any](f func(t iterable.Tuple[A, B]) C) func(a A, b B) C {
+	return func(a A, b B) C {
+		return f(iterable.Tuple[A, B]{A: a, B: b})
+	}
+}
+
+// Pair returns a Tuple of a and b.
+func Pair[A any, B any](a A, b B) iterable.Tuple[A, B] {
+	return iterable.Tuple[A, B]{A: a, B: b}
+}
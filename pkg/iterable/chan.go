@@ -0,0 +1,71 @@
+package iterable
+
+import "context"
+
+type chanIterable[T any] struct {
+	*funcIterable[T]
+	ch <-chan T
+}
+
+// FromChan returns an Iterable over the values received from ch until it is
+// closed.
+func FromChan[T any](ch <-chan T) Iterable[T] {
+	next := func() (T, bool) {
+		v, ok := <-ch
+		return v, ok
+	}
+	return &chanIterable[T]{&funcIterable[T]{next: next}, ch}
+}
+
+// ToChan sends the elements of it to the returned channel, which is closed
+// once it is exhausted or ctx is done. The elements are sent by a goroutine
+// that blocks until they are received: if the consumer stops early it must
+// cancel ctx, or the goroutine leaks. An untouched Iterable created by
+// FromChan hands back its channel unchanged when ctx can never be done, and
+// is otherwise forwarded without blocking on it once ctx is done.
+func ToChan[T any](ctx context.Context, it Iterable[T]) <-chan T {
+	if c, ok := it.(*chanIterable[T]); ok && !c.ok && !c.done {
+		if ctx.Done() == nil {
+			return c.ch
+		}
+		return forward(ctx, c.ch)
+	}
+
+	res := make(chan T)
+	go func() {
+		defer close(res)
+		for v, ok := it.TryNext(); ok; v, ok = it.TryNext() {
+			select {
+			case res <- v:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return res
+}
+
+// forward copies in to the returned channel until in is closed or ctx is
+// done, without blocking on in once ctx is done.
+func forward[T any](ctx context.Context, in <-chan T) <-chan T {
+	res := make(chan T)
+	go func() {
+		defer close(res)
+		for {
+			select {
+			case v, ok := <-in:
+				if !ok {
+					return
+				}
+				select {
+				case res <- v:
+				case <-ctx.Done():
+					return
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return res
+}
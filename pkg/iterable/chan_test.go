@@ -0,0 +1,65 @@
+package iterable_test
+
+import (
+	"context"
+	"github.com/sergeychunayev/gofu/pkg/iterable"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestFromChan(t *testing.T) {
+	ch := make(chan int, 3)
+	ch <- 1
+	ch <- 2
+	ch <- 3
+	close(ch)
+	res := iterable.FromChan(ch).Filter(func(v int) bool {
+		return v > 1
+	}).ToSlice()
+	require.Equal(t, []int{2, 3}, res)
+}
+
+func TestToChan(t *testing.T) {
+	var res []int
+	for v := range iterable.ToChan(context.Background(), iterable.New([]int{1, 2, 3})) {
+		res = append(res, v)
+	}
+	require.Equal(t, []int{1, 2, 3}, res)
+}
+
+func TestToChan_FromChan(t *testing.T) {
+	ch := make(chan int)
+	require.Equal(t, (<-chan int)(ch), iterable.ToChan(context.Background(), iterable.FromChan(ch)))
+}
+
+func TestToChan_Cancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	ch := iterable.ToChan(ctx, iterable.New([]int{1, 2, 3}))
+	require.Equal(t, 1, <-ch)
+	cancel()
+	for range ch {
+	}
+}
+
+func TestToChan_FromChanCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	ch := make(chan int)
+	defer close(ch)
+	for range iterable.ToChan(ctx, iterable.FromChan(ch)) {
+	}
+}
+
+func TestToChan_FromChanContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ch := make(chan int, 2)
+	ch <- 1
+	ch <- 2
+	close(ch)
+	var res []int
+	for v := range iterable.ToChan(ctx, iterable.FromChan(ch)) {
+		res = append(res, v)
+	}
+	require.Equal(t, []int{1, 2}, res)
+}
@@ -0,0 +1,72 @@
+package csv
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+)
+
+// Options configures reading and writing. The zero value reads and writes
+// comma-separated records with a header row.
+type Options struct {
+	// Comma is the field delimiter. Defaults to ','.
+	Comma rune
+
+	// Comment, if not 0, marks lines to be ignored when reading.
+	Comment rune
+
+	// NoHeader reports that the input has no header row, in which case
+	// columns are mapped to struct fields in declaration order, and that
+	// no header row should be written.
+	NoHeader bool
+
+	// OnError is called for every row that fails to parse or decode.
+	// Returning true skips the row and continues; returning false stops
+	// the iteration with that error. A nil OnError stops on the first
+	// error.
+	OnError func(err *RowError) bool
+
+	// UseCRLF makes the writer terminate lines with \r\n.
+	UseCRLF bool
+}
+
+// RowError describes a row that could not be read or decoded.
+type RowError struct {
+	Line   int
+	Column string
+	Err    error
+}
+
+func (e *RowError) Error() string {
+	if e.Column != "" {
+		return fmt.Sprintf("csv: line %d, column %q: %v", e.Line, e.Column, e.Err)
+	}
+	return fmt.Sprintf("csv: line %d: %v", e.Line, e.Err)
+}
+
+func (e *RowError) Unwrap() error {
+	return e.Err
+}
+
+func (o Options) reader(r io.Reader) *csv.Reader {
+	res := csv.NewReader(r)
+	if o.Comma != 0 {
+		res.Comma = o.Comma
+	}
+	res.Comment = o.Comment
+	return res
+}
+
+func (o Options) writer(w io.Writer) *csv.Writer {
+	res := csv.NewWriter(w)
+	if o.Comma != 0 {
+		res.Comma = o.Comma
+	}
+	res.UseCRLF = o.UseCRLF
+	return res
+}
+
+// skip reports whether err should be skipped according to OnError.
+func (o Options) skip(err *RowError) bool {
+	return o.OnError != nil && o.OnError(err)
+}
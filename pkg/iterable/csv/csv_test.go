@@ -0,0 +1,238 @@
+package csv_test
+
+import (
+	"bytes"
+	"errors"
+	"github.com/sergeychunayev/gofu/pkg/iterable"
+	"github.com/sergeychunayev/gofu/pkg/iterable/csv"
+	"github.com/stretchr/testify/require"
+	"io"
+	"strconv"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+type person struct {
+	Name    string
+	Age     int     `csv:"age"`
+	Score   float64 `csv:"score"`
+	Active  bool
+	Ignored string `csv:"-"`
+	private string
+}
+
+type upper string
+
+func (u *upper) UnmarshalText(b []byte) error {
+	*u = upper(strings.ToUpper(string(b)))
+	return nil
+}
+
+func (u upper) MarshalText() ([]byte, error) {
+	return []byte(strings.ToLower(string(u))), nil
+}
+
+func TestRecords(t *testing.T) {
+	itr, errF := csv.Records(strings.NewReader("a,b\n1,2\n3,4\n"), csv.Options{})
+	require.Equal(t, [][]string{{"a", "b"}, {"1", "2"}, {"3", "4"}}, itr.ToSlice())
+	require.NoError(t, errF())
+}
+
+func TestRecords_Options(t *testing.T) {
+	input := "# comment\na;b\n1;2\n"
+	itr, errF := csv.Records(strings.NewReader(input), csv.Options{Comma: ';', Comment: '#'})
+	require.Equal(t, [][]string{{"a", "b"}, {"1", "2"}}, itr.ToSlice())
+	require.NoError(t, errF())
+}
+
+func TestRecords_RowError(t *testing.T) {
+	input := "a,b\n1,2,3\n3,4\n"
+	testCases := []struct {
+		name     string
+		onError  func(err *csv.RowError) bool
+		expected [][]string
+		line     int
+	}{
+		{
+			"Stop",
+			nil,
+			[][]string{{"a", "b"}},
+			2,
+		},
+		{
+			"Skip",
+			func(err *csv.RowError) bool {
+				return true
+			},
+			[][]string{{"a", "b"}, {"3", "4"}},
+			0,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			itr, errF := csv.Records(strings.NewReader(input), csv.Options{OnError: tc.onError})
+			require.Equal(t, tc.expected, itr.ToSlice())
+			if tc.line == 0 {
+				require.NoError(t, errF())
+				return
+			}
+			var rowErr *csv.RowError
+			require.ErrorAs(t, errF(), &rowErr)
+			require.Equal(t, tc.line, rowErr.Line)
+		})
+	}
+}
+
+func TestRecords_ReadError(t *testing.T) {
+	fail := errors.New("fail")
+	r := io.MultiReader(strings.NewReader("a,b\n"), iotest.ErrReader(fail))
+	itr, errF := csv.Records(r, csv.Options{
+		OnError: func(err *csv.RowError) bool {
+			return true
+		},
+	})
+	require.Equal(t, [][]string{{"a", "b"}}, itr.ToSlice())
+	require.ErrorIs(t, errF(), fail)
+}
+
+func TestDecode(t *testing.T) {
+	input := "score,age,NAME,active,extra\n1.5,30,bob,true,x\n2,31,alice,false,y\n"
+	itr, errF := csv.Decode[person](strings.NewReader(input), csv.Options{})
+	expected := []person{
+		{Name: "bob", Age: 30, Score: 1.5, Active: true},
+		{Name: "alice", Age: 31, Score: 2, Active: false},
+	}
+	require.Equal(t, expected, itr.ToSlice())
+	require.NoError(t, errF())
+}
+
+func TestDecode_TagIsCaseSensitive(t *testing.T) {
+	itr, errF := csv.Decode[person](strings.NewReader("AGE,name\n30,bob\n"), csv.Options{})
+	require.Equal(t, []person{{Name: "bob"}}, itr.ToSlice())
+	require.NoError(t, errF())
+}
+
+func TestDecode_NoHeader(t *testing.T) {
+	input := "bob,30,1.5,true\n"
+	itr, errF := csv.Decode[person](strings.NewReader(input), csv.Options{NoHeader: true})
+	require.Equal(t, []person{{Name: "bob", Age: 30, Score: 1.5, Active: true}}, itr.ToSlice())
+	require.NoError(t, errF())
+}
+
+func TestDecode_TextUnmarshaler(t *testing.T) {
+	type s struct {
+		V upper `csv:"v"`
+	}
+	itr, errF := csv.Decode[s](strings.NewReader("v\nabc\n"), csv.Options{})
+	require.Equal(t, []s{{"ABC"}}, itr.ToSlice())
+	require.NoError(t, errF())
+}
+
+func TestDecode_RowError(t *testing.T) {
+	input := "name,age\nbob,x\nalice,31\n"
+
+	itr, errF := csv.Decode[person](strings.NewReader(input), csv.Options{})
+	require.Empty(t, itr.ToSlice())
+	var rowErr *csv.RowError
+	require.ErrorAs(t, errF(), &rowErr)
+	require.Equal(t, 2, rowErr.Line)
+	require.Equal(t, "age", rowErr.Column)
+	require.ErrorIs(t, rowErr, strconv.ErrSyntax)
+
+	var reported []int
+	itr, errF = csv.Decode[person](strings.NewReader(input), csv.Options{
+		OnError: func(err *csv.RowError) bool {
+			reported = append(reported, err.Line)
+			return true
+		},
+	})
+	require.Equal(t, []person{{Name: "alice", Age: 31}}, itr.ToSlice())
+	require.NoError(t, errF())
+	require.Equal(t, []int{2}, reported)
+}
+
+// marshalOnly implements TextMarshaler but not TextUnmarshaler.
+type marshalOnly struct {
+	v []int
+}
+
+func (m marshalOnly) MarshalText() ([]byte, error) {
+	return []byte(strconv.Itoa(len(m.v))), nil
+}
+
+func TestDecode_MarshalOnly(t *testing.T) {
+	type s struct {
+		V marshalOnly `csv:"v"`
+	}
+	itr, errF := csv.Decode[s](strings.NewReader("v\n1\n"), csv.Options{})
+	require.False(t, itr.HasNext())
+	require.ErrorContains(t, errF(), "unsupported type")
+
+	var buf bytes.Buffer
+	require.NoError(t, csv.Encode(iterable.New([]s{{marshalOnly{[]int{1, 2}}}}), &buf, csv.Options{}))
+	require.Equal(t, "v\n2\n", buf.String())
+}
+
+func TestTagOptions(t *testing.T) {
+	type s struct {
+		Name string `csv:"name,omitempty"`
+		Age  int    `csv:",omitempty"`
+	}
+	var buf bytes.Buffer
+	require.NoError(t, csv.Encode(iterable.New([]s{{"bob", 30}}), &buf, csv.Options{}))
+	require.Equal(t, "name,Age\nbob,30\n", buf.String())
+
+	itr, errF := csv.Decode[s](&buf, csv.Options{})
+	require.Equal(t, []s{{"bob", 30}}, itr.ToSlice())
+	require.NoError(t, errF())
+}
+
+func TestDecode_NotStruct(t *testing.T) {
+	itr, errF := csv.Decode[int](strings.NewReader("a\n1\n"), csv.Options{})
+	require.False(t, itr.HasNext())
+	require.Error(t, errF())
+}
+
+func TestWriteRecords(t *testing.T) {
+	var buf bytes.Buffer
+	err := csv.WriteRecords(iterable.New([][]string{{"a", "b"}, {"1", "2,3"}}), &buf, csv.Options{Comma: ';'})
+	require.NoError(t, err)
+	require.Equal(t, "a;b\n1;2,3\n", buf.String())
+}
+
+func TestEncode(t *testing.T) {
+	testCases := []struct {
+		name     string
+		opts     csv.Options
+		expected string
+	}{
+		{"Header", csv.Options{}, "Name,age,score,Active\nbob,30,1.5,true\n"},
+		{"No header", csv.Options{NoHeader: true}, "bob,30,1.5,true\n"},
+		{"CRLF", csv.Options{NoHeader: true, UseCRLF: true}, "bob,30,1.5,true\r\n"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			itr := iterable.New([]person{{Name: "bob", Age: 30, Score: 1.5, Active: true, Ignored: "x"}})
+			err := csv.Encode(itr, &buf, tc.opts)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, buf.String())
+		})
+	}
+}
+
+func TestEncode_RoundTrip(t *testing.T) {
+	type s struct {
+		V upper `csv:"v"`
+		N uint8 `csv:"n"`
+	}
+	input := []s{{"ABC", 1}, {"DEF", 2}}
+	var buf bytes.Buffer
+	require.NoError(t, csv.Encode(iterable.New(input), &buf, csv.Options{}))
+	require.Equal(t, "v,n\nabc,1\ndef,2\n", buf.String())
+
+	itr, errF := csv.Decode[s](&buf, csv.Options{})
+	require.Equal(t, input, itr.ToSlice())
+	require.NoError(t, errF())
+}
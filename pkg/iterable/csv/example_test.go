@@ -0,0 +1,38 @@
+package csv_test
+
+import (
+	"fmt"
+	"github.com/sergeychunayev/gofu/pkg/iterable"
+	"github.com/sergeychunayev/gofu/pkg/iterable/csv"
+	"os"
+	"strings"
+)
+
+func ExampleDecode() {
+	type S struct {
+		Name  string `csv:"name"`
+		Value int    `csv:"value"`
+	}
+	itr, errF := csv.Decode[S](strings.NewReader("name,value\none,1\ntwo,2\n"), csv.Options{})
+	itr.For(func(v S, _ int) {
+		fmt.Printf("%+v\n", v)
+	})
+	fmt.Println(errF())
+	// Output:
+	// {Name:one Value:1}
+	// {Name:two Value:2}
+	// <nil>
+}
+
+func ExampleEncode() {
+	type S struct {
+		Name  string `csv:"name"`
+		Value int    `csv:"value"`
+	}
+	itr := iterable.New([]S{{"one", 1}, {"two", 2}})
+	_ = csv.Encode(itr, os.Stdout, csv.Options{})
+	// Output:
+	// name,value
+	// one,1
+	// two,2
+}
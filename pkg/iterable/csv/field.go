@@ -0,0 +1,138 @@
+package csv
+
+import (
+	"encoding"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+var (
+	textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+	textMarshalerType   = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+)
+
+type field struct {
+	name   string
+	tagged bool
+	index  int
+}
+
+// fieldsOf returns the columns of struct type t. forDecode selects whether
+// the field types must be decodable or encodable.
+func fieldsOf(t reflect.Type, forDecode bool) ([]*field, error) {
+	if t.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("csv: %v is not a struct type", t)
+	}
+	var res []*field
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		if !sf.IsExported() {
+			continue
+		}
+		tag := sf.Tag.Get("csv")
+		if tag == "-" {
+			continue
+		}
+		// options after the name, as in csv:"name,omitempty", are ignored
+		name, _, _ := strings.Cut(tag, ",")
+		ok := encodable(sf.Type)
+		if forDecode {
+			ok = decodable(sf.Type)
+		}
+		if !ok {
+			return nil, fmt.Errorf("csv: unsupported type %v of field %s", sf.Type, sf.Name)
+		}
+		f := &field{sf.Name, false, i}
+		if name != "" {
+			f.name = name
+			f.tagged = true
+		}
+		res = append(res, f)
+	}
+	return res, nil
+}
+
+func decodable(t reflect.Type) bool {
+	return reflect.PointerTo(t).Implements(textUnmarshalerType) || basic(t)
+}
+
+func encodable(t reflect.Type) bool {
+	return t.Implements(textMarshalerType) || basic(t)
+}
+
+func basic(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.String, reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
+}
+
+func (f *field) matches(name string) bool {
+	if f.tagged {
+		return f.name == name
+	}
+	return strings.EqualFold(f.name, name)
+}
+
+func (f *field) decode(dst reflect.Value, s string) error {
+	if u, ok := dst.Addr().Interface().(encoding.TextUnmarshaler); ok {
+		return u.UnmarshalText([]byte(s))
+	}
+	switch dst.Kind() {
+	case reflect.String:
+		dst.SetString(s)
+	case reflect.Bool:
+		v, err := strconv.ParseBool(s)
+		if err != nil {
+			return err
+		}
+		dst.SetBool(v)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		v, err := strconv.ParseInt(s, 10, dst.Type().Bits())
+		if err != nil {
+			return err
+		}
+		dst.SetInt(v)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		v, err := strconv.ParseUint(s, 10, dst.Type().Bits())
+		if err != nil {
+			return err
+		}
+		dst.SetUint(v)
+	case reflect.Float32, reflect.Float64:
+		v, err := strconv.ParseFloat(s, dst.Type().Bits())
+		if err != nil {
+			return err
+		}
+		dst.SetFloat(v)
+	default:
+		return fmt.Errorf("csv: unsupported type %v", dst.Type())
+	}
+	return nil
+}
+
+func (f *field) encode(src reflect.Value) (string, error) {
+	if m, ok := src.Interface().(encoding.TextMarshaler); ok {
+		b, err := m.MarshalText()
+		return string(b), err
+	}
+	switch src.Kind() {
+	case reflect.String:
+		return src.String(), nil
+	case reflect.Bool:
+		return strconv.FormatBool(src.Bool()), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(src.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(src.Uint(), 10), nil
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(src.Float(), 'g', -1, src.Type().Bits()), nil
+	}
+	return "", fmt.Errorf("csv: unsupported type %v", src.Type())
+}
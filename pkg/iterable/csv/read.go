@@ -0,0 +1,130 @@
+package csv
+
+import (
+	"encoding/csv"
+	"errors"
+	"github.com/sergeychunayev/gofu/pkg/iterable"
+	"io"
+	"reflect"
+)
+
+// Records lazily reads the records of r, including the header row if any.
+// The returned func reports the error that stopped the iteration, if any.
+func Records(r io.Reader, opts Options) (iterable.Iterable[[]string], func() error) {
+	cr := opts.reader(r)
+	var err error
+	next := func() ([]string, bool) {
+		if err != nil {
+			return nil, false
+		}
+		for {
+			rec, e := cr.Read()
+			if e == nil {
+				return rec, true
+			}
+			if e == io.EOF {
+				return nil, false
+			}
+			rowErr := toRowError(cr, e)
+			if !isParseError(e) || !opts.skip(rowErr) {
+				err = rowErr
+				return nil, false
+			}
+		}
+	}
+	return iterable.FromFunc(next), func() error {
+		return err
+	}
+}
+
+// Decode lazily reads the records of r and decodes them into values of the
+// struct type T. Columns are matched to fields by the `csv` struct tag or,
+// without a tag, by the case-insensitive field name. Anything after a comma
+// in the tag is ignored, and a tag of "-" ignores the field. Fields must be
+// of a basic type or implement encoding.TextUnmarshaler. The returned func
+// reports the error that stopped the iteration, if any.
+func Decode[T any](r io.Reader, opts Options) (iterable.Iterable[T], func() error) {
+	cr := opts.reader(r)
+	fields, err := fieldsOf(reflect.TypeOf((*T)(nil)).Elem(), true)
+	var cols []*field
+	next := func() (T, bool) {
+		var res T
+		if err != nil {
+			return res, false
+		}
+		for {
+			rec, e := cr.Read()
+			if e == io.EOF {
+				return res, false
+			}
+			if e != nil {
+				rowErr := toRowError(cr, e)
+				if isParseError(e) && opts.skip(rowErr) {
+					continue
+				}
+				err = rowErr
+				return res, false
+			}
+			if cols == nil {
+				if opts.NoHeader {
+					cols = fields
+				} else {
+					cols = matchHeader(fields, rec)
+					continue
+				}
+			}
+			if rowErr := decodeRecord(cr, cols, rec, reflect.ValueOf(&res).Elem()); rowErr != nil {
+				if opts.skip(rowErr) {
+					res = *new(T)
+					continue
+				}
+				err = rowErr
+				return res, false
+			}
+			return res, true
+		}
+	}
+	return iterable.FromFunc(next), func() error {
+		return err
+	}
+}
+
+func matchHeader(fields []*field, header []string) []*field {
+	res := make([]*field, len(header))
+	for i, name := range header {
+		for _, f := range fields {
+			if f.matches(name) {
+				res[i] = f
+				break
+			}
+		}
+	}
+	return res
+}
+
+func decodeRecord(cr *csv.Reader, cols []*field, rec []string, dst reflect.Value) *RowError {
+	for i, s := range rec {
+		if i >= len(cols) || cols[i] == nil {
+			continue
+		}
+		if err := cols[i].decode(dst.Field(cols[i].index), s); err != nil {
+			line, _ := cr.FieldPos(i)
+			return &RowError{line, cols[i].name, err}
+		}
+	}
+	return nil
+}
+
+func toRowError(cr *csv.Reader, err error) *RowError {
+	var pe *csv.ParseError
+	if errors.As(err, &pe) {
+		return &RowError{Line: pe.Line, Err: pe.Err}
+	}
+	line, _ := cr.FieldPos(0)
+	return &RowError{Line: line, Err: err}
+}
+
+func isParseError(err error) bool {
+	var pe *csv.ParseError
+	return errors.As(err, &pe)
+}
@@ -0,0 +1,54 @@
+package csv
+
+import (
+	"github.com/sergeychunayev/gofu/pkg/iterable"
+	"io"
+	"reflect"
+)
+
+// WriteRecords writes every record of it to w.
+func WriteRecords(it iterable.Iterable[[]string], w io.Writer, opts Options) error {
+	cw := opts.writer(w)
+	for v, ok := it.TryNext(); ok; v, ok = it.TryNext() {
+		if err := cw.Write(v); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// Encode writes every element of it to w as a CSV record, preceded by a
+// header row unless opts.NoHeader is set. T must be a struct type; fields
+// are mapped to columns the same way as in Decode.
+func Encode[T any](it iterable.Iterable[T], w io.Writer, opts Options) error {
+	fields, err := fieldsOf(reflect.TypeOf((*T)(nil)).Elem(), false)
+	if err != nil {
+		return err
+	}
+	cw := opts.writer(w)
+	rec := make([]string, len(fields))
+	if !opts.NoHeader {
+		for i, f := range fields {
+			rec[i] = f.name
+		}
+		if err := cw.Write(rec); err != nil {
+			return err
+		}
+	}
+	for v, ok := it.TryNext(); ok; v, ok = it.TryNext() {
+		src := reflect.ValueOf(&v).Elem()
+		for i, f := range fields {
+			s, err := f.encode(src.Field(f.index))
+			if err != nil {
+				return err
+			}
+			rec[i] = s
+		}
+		if err := cw.Write(rec); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
@@ -1,19 +1,165 @@
 package iterable
 
+// cycleIterable repeats the elements of its source. During the first pass
+// they are pulled from src one at a time and buffered in slice; later
+// passes replay the buffer, so cycling a long or infinite Iterable does not
+// block before the first element.
 type cycleIterable[T any] struct {
-	*Slice[T]
+	slice []T
+	i     int
+	// src is the source still being consumed, or nil once slice holds a
+	// whole pass.
+	src Iterable[T]
+	// passes counts the passes left including the current one, or is
+	// negative to repeat forever.
+	passes int
+}
+
+func newCycle[T any](it Iterable[T], passes int) Iterable[T] {
+	if passes == 0 {
+		return New[T](nil)
+	}
+	if s, ok := it.(*Slice[T]); ok {
+		return &cycleIterable[T]{slice: s.slice[s.i:], passes: passes}
+	}
+	return &cycleIterable[T]{slice: make([]T, 0, capHint(it)), src: it, passes: passes}
+}
+
+// CycleN returns an Iterable over the elements of it repeated n times.
+// Like Cycle, it buffers the elements during the first pass.
+func CycleN[T any](it Iterable[T], n int) Iterable[T] {
+	if n < 0 {
+		n = 0
+	}
+	return newCycle(it, n)
+}
+
+// RepeatEach returns an Iterable that yields every element of it k times
+// in a row.
+func RepeatEach[T any](it Iterable[T], k int) Iterable[T] {
+	var cur T
+	left := 0
+	return FromFunc(func() (T, bool) {
+		if k <= 0 {
+			var zero T
+			return zero, false
+		}
+		if left == 0 {
+			v, ok := it.TryNext()
+			if !ok {
+				return v, false
+			}
+			cur, left = v, k
+		}
+		left--
+		return cur, true
+	})
+}
+
+// endPass is called once the source is exhausted.
+func (v *cycleIterable[T]) endPass() {
+	v.src = nil
+	v.i = 0
+	if v.passes > 0 {
+		v.passes--
+	}
 }
 
 func (v *cycleIterable[T]) HasNext() bool {
-	return len(v.slice) > 0
+	if v.src != nil {
+		if v.src.HasNext() {
+			return true
+		}
+		v.endPass()
+	}
+	return len(v.slice) > 0 && v.passes != 0
 }
 
 func (v *cycleIterable[T]) Next() T {
+	return mustNext[T](v)
+}
+
+func (v *cycleIterable[T]) TryNext() (T, bool) {
+	if v.src != nil {
+		if el, ok := v.src.TryNext(); ok {
+			v.slice = append(v.slice, el)
+			return el, true
+		}
+		v.endPass()
+	}
+	if len(v.slice) == 0 || v.passes == 0 {
+		var zero T
+		return zero, false
+	}
 	res := v.slice[v.i]
 	v.i++
 	if v.i == len(v.slice) {
 		v.i = 0
+		if v.passes > 0 {
+			v.passes--
+		}
 	}
+	return res, true
+}
+
+func (v *cycleIterable[T]) Filter(f func(v T) bool) Iterable[T] {
+	return newFilter[T](v, f)
+}
+
+func (v *cycleIterable[T]) For(f func(v T, i int)) {
+	doFor[T](v, f)
+}
 
-	return res
+func (v *cycleIterable[T]) All(f func(v T) bool) bool {
+	return all[T](v, f)
+}
+
+func (v *cycleIterable[T]) Any(f func(v T) bool) bool {
+	return doAny[T](v, f)
+}
+
+func (v *cycleIterable[T]) Reduce(f func(acc T, v T) T) (T, bool) {
+	return reduce[T](v, f)
+}
+
+func (v *cycleIterable[T]) Count() int {
+	return count[T](v)
+}
+
+func (v *cycleIterable[T]) Sort(less func(a T, b T) bool) Iterable[T] {
+	return doSort[T](v, less)
+}
+
+func (v *cycleIterable[T]) Cycle() Iterable[T] {
+	if v.passes < 0 {
+		return v
+	}
+	return cycle[T](v)
+}
+
+func (v *cycleIterable[T]) Reverse() Iterable[T] {
+	return reverse[T](v)
+}
+
+func (v *cycleIterable[T]) Last() (T, bool) {
+	return last[T](v)
+}
+
+func (v *cycleIterable[T]) TakeLast(n int) Iterable[T] {
+	return takeLast[T](v, n)
+}
+
+// ToSlice returns the elements left if the number of passes is limited,
+// and a single pass otherwise.
+func (v *cycleIterable[T]) ToSlice() []T {
+	if v.passes >= 0 {
+		return toSlice[T](v)
+	}
+	if v.src != nil {
+		for el, ok := v.src.TryNext(); ok; el, ok = v.src.TryNext() {
+			v.slice = append(v.slice, el)
+		}
+		v.src = nil
+	}
+	return v.slice
 }
@@ -0,0 +1,108 @@
+package iterable_test
+
+import (
+	"github.com/sergeychunayev/gofu/pkg/iterable"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+// naturals returns an infinite Iterable over 1, 2, 3...
+func naturals() iterable.Iterable[int] {
+	i := 0
+	return iterable.FromFunc(func() (int, bool) {
+		i++
+		return i, true
+	})
+}
+
+func take[T any](it iterable.Iterable[T], n int) []T {
+	var res []T
+	for i := 0; i < n; i++ {
+		v, ok := it.TryNext()
+		if !ok {
+			break
+		}
+		res = append(res, v)
+	}
+	return res
+}
+
+func TestCycle_Lazy(t *testing.T) {
+	res := take(naturals().Filter(isEven).Cycle(), 3)
+	require.Equal(t, []int{2, 4, 6}, res)
+}
+
+func TestCycle_Replays(t *testing.T) {
+	pulled := 0
+	it := counting([]int{1, 2, 3}, &pulled).Filter(func(v int) bool {
+		return v != 2
+	}).Cycle()
+	require.Equal(t, []int{1, 3, 1, 3, 1}, take(it, 5))
+	require.Equal(t, 3, pulled)
+}
+
+func TestCycle_EmptySource(t *testing.T) {
+	it := upTo(0).Cycle()
+	require.False(t, it.HasNext())
+	require.Nil(t, take(it, 3))
+}
+
+func TestCycleN(t *testing.T) {
+	testCases := []struct {
+		name     string
+		input    func() iterable.Iterable[int]
+		n        int
+		expected []int
+	}{
+		{"Slice", func() iterable.Iterable[int] { return iterable.New([]int{1, 2}) }, 3, []int{1, 2, 1, 2, 1, 2}},
+		{"Lazy", func() iterable.Iterable[int] { return upTo(2) }, 3, []int{1, 2, 1, 2, 1, 2}},
+		{"Once", func() iterable.Iterable[int] { return upTo(2) }, 1, []int{1, 2}},
+		{"Zero", func() iterable.Iterable[int] { return upTo(2) }, 0, nil},
+		{"Negative", func() iterable.Iterable[int] { return upTo(2) }, -1, nil},
+		{"Empty", func() iterable.Iterable[int] { return upTo(0) }, 3, nil},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, iterable.CycleN(tc.input(), tc.n).ToSlice())
+
+			var res []int
+			it := iterable.CycleN(tc.input(), tc.n)
+			for it.HasNext() {
+				res = append(res, it.Next())
+			}
+			require.Equal(t, tc.expected, res)
+		})
+	}
+}
+
+func TestCycleN_SizeHint(t *testing.T) {
+	it := iterable.CycleN(iterable.Map(iterable.New([]int{1, 2, 3}), double), 2)
+	n, ok := iterable.Len(it)
+	require.True(t, ok)
+	require.Equal(t, 6, n)
+
+	it.Next()
+	n, ok = iterable.Len(it)
+	require.True(t, ok)
+	require.Equal(t, 5, n)
+
+	take(it, 3)
+	n, ok = iterable.Len(it)
+	require.True(t, ok)
+	require.Equal(t, 2, n)
+
+	_, ok = iterable.Len(iterable.New([]int{1}).Cycle())
+	require.False(t, ok)
+}
+
+func TestCycleN_Cycle(t *testing.T) {
+	it := iterable.CycleN(iterable.New([]int{1, 2}), 2).Cycle()
+	require.Equal(t, []int{1, 2, 1, 2, 1, 2}, take(it, 6))
+}
+
+func TestRepeatEach(t *testing.T) {
+	require.Equal(t, []int{1, 1, 2, 2, 3, 3}, iterable.RepeatEach(upTo(3), 2).ToSlice())
+	require.Equal(t, []int{1, 2}, iterable.RepeatEach(upTo(2), 1).ToSlice())
+	require.Nil(t, iterable.RepeatEach(upTo(2), 0).ToSlice())
+	require.Equal(t, []int{1, 1, 1, 2}, take(iterable.RepeatEach(naturals(), 3), 4))
+}
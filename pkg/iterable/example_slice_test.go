@@ -3,6 +3,7 @@ package iterable_test
 import (
 	"fmt"
 	"github.com/sergeychunayev/gofu/pkg/iterable"
+	"strings"
 )
 
 func ExampleSlice_HasNext() {
@@ -201,3 +202,74 @@ func ExampleGroupBy() {
 	fmt.Println(res)
 	// Output: map[false:[1 3] true:[2]]
 }
+
+func ExampleLines() {
+	itr, errF := iterable.Lines(strings.NewReader("one\ntwo\nthree\n"))
+	res := iterable.Map(itr, strings.ToUpper).ToSlice()
+	fmt.Println(res, errF())
+	// Output: [ONE TWO THREE] <nil>
+}
+
+func ExampleTee() {
+	its := iterable.Tee(iterable.New([]int{1, 2, 3}), 2)
+	sum, _ := its[0].Reduce(func(acc int, v int) int {
+		return acc + v
+	})
+	fmt.Println(sum, its[1].ToSlice())
+	// Output: 6 [1 2 3]
+}
+
+func ExampleBroadcast() {
+	res := iterable.Broadcast(
+		iterable.New([]int{1, 2, 3}),
+		func(it iterable.Iterable[int]) int {
+			res, _ := it.Reduce(func(acc int, v int) int {
+				return acc + v
+			})
+			return res
+		},
+		func(it iterable.Iterable[int]) int {
+			return len(it.ToSlice())
+		},
+	)
+	fmt.Println(res)
+	// Output: [6 3]
+}
+
+func ExampleMemoize() {
+	memo := iterable.Memoize(iterable.Map(iterable.New([]int{1, 2, 3}), func(v int) int {
+		fmt.Println("computing", v)
+		return v * v
+	}))
+	fmt.Println(memo.Iter().ToSlice())
+	fmt.Println(memo.Iter().ToSlice())
+	// Output:
+	// computing 1
+	// computing 2
+	// computing 3
+	// [1 4 9]
+	// [1 4 9]
+}
+
+func ExampleSlice_Reverse() {
+	res := iterable.New([]int{1, 2, 3, 4}).
+		Filter(func(v int) bool {
+			return v%2 == 0
+		}).
+		Reverse().
+		ToSlice()
+	fmt.Println(res)
+	// Output: [4 2]
+}
+
+func ExampleSlice_TakeLast() {
+	res := iterable.New([]int{1, 2, 3, 4}).TakeLast(2).ToSlice()
+	fmt.Println(res)
+	// Output: [3 4]
+}
+
+func ExampleCycleN() {
+	res := iterable.CycleN(iterable.New([]string{"a", "b"}), 2).ToSlice()
+	fmt.Println(res, iterable.RepeatEach(iterable.New([]string{"a", "b"}), 2).ToSlice())
+	// Output: [a b a b] [a a b b]
+}
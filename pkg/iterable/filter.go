@@ -1,33 +1,76 @@
 package iterable
 
 type filterIterable[T any] struct {
-	Iterable[T]
+	itr    Iterable[T]
 	filter func(v T) bool
-	cur    *T
+	// cur holds an element buffered by HasNext.
+	cur *T
+}
+
+// deFilterIterable is a filterIterable over a DoubleEnded source.
+type deFilterIterable[T any] struct {
+	*filterIterable[T]
+	back DoubleEnded[T]
+}
+
+func newFilter[T any](it Iterable[T], f func(v T) bool) Iterable[T] {
+	res := &filterIterable[T]{it, f, nil}
+	if de, ok := it.(DoubleEnded[T]); ok {
+		return &deFilterIterable[T]{res, de}
+	}
+	return res
 }
 
 func (v *filterIterable[T]) HasNext() bool {
-	if v.cur != nil {
-		return true
+	if v.cur == nil {
+		if el, ok := v.TryNext(); ok {
+			v.cur = &el
+		}
 	}
+	return v.cur != nil
+}
 
-	itr := v.Iterable
-	for itr.HasNext() {
-		el := itr.Next()
+func (v *filterIterable[T]) Next() T {
+	return mustNext[T](v)
+}
+
+func (v *filterIterable[T]) TryNext() (T, bool) {
+	if v.cur != nil {
+		res := *v.cur
+		v.cur = nil
+		return res, true
+	}
+	for el, ok := v.itr.TryNext(); ok; el, ok = v.itr.TryNext() {
 		if v.filter(el) {
-			v.cur = &el
-			return true
+			return el, true
 		}
 	}
+	var zero T
+	return zero, false
+}
 
-	return false
+func (v *filterIterable[T]) Filter(f func(v T) bool) Iterable[T] {
+	return newFilter[T](v, f)
 }
 
-func (v *filterIterable[T]) Next() T {
-	res := *v.cur
-	v.cur = nil
-	v.HasNext()
-	return res
+func (v *filterIterable[T]) For(f func(v T, i int)) {
+	doFor[T](v, f)
+}
+
+func (v *filterIterable[T]) All(f func(v T) bool) bool {
+	return all[T](v, f)
+}
+
+func (v *filterIterable[T]) Any(f func(v T) bool) bool {
+	return doAny[T](v, f)
+}
+
+func (v *filterIterable[T]) Reduce(f func(acc T, v T) T) (T, bool) {
+	return reduce[T](v, f)
+}
+
+func (v *filterIterable[T]) Count() int {
+	return count[T](v)
 }
 
 func (v *filterIterable[T]) Sort(less func(a T, b T) bool) Iterable[T] {
@@ -38,6 +81,51 @@ func (v *filterIterable[T]) Cycle() Iterable[T] {
 	return cycle[T](v)
 }
 
+func (v *filterIterable[T]) Reverse() Iterable[T] {
+	return reverse[T](v)
+}
+
+func (v *filterIterable[T]) Last() (T, bool) {
+	return last[T](v)
+}
+
+func (v *filterIterable[T]) TakeLast(n int) Iterable[T] {
+	return takeLast[T](v, n)
+}
+
 func (v *filterIterable[T]) ToSlice() []T {
 	return toSlice[T](v)
 }
+
+func (v *deFilterIterable[T]) NextBack() T {
+	for v.back.HasNext() {
+		el := v.back.NextBack()
+		if v.filter(el) {
+			return el
+		}
+	}
+
+	// the only element left is the one buffered by HasNext
+	if v.cur == nil {
+		panic(ErrExhausted)
+	}
+	res := *v.cur
+	v.cur = nil
+	return res
+}
+
+func (v *deFilterIterable[T]) Filter(f func(v T) bool) Iterable[T] {
+	return newFilter[T](v, f)
+}
+
+func (v *deFilterIterable[T]) Reverse() Iterable[T] {
+	return reverse[T](v)
+}
+
+func (v *deFilterIterable[T]) Last() (T, bool) {
+	return last[T](v)
+}
+
+func (v *deFilterIterable[T]) TakeLast(n int) Iterable[T] {
+	return takeLast[T](v, n)
+}
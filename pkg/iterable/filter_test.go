@@ -63,3 +63,18 @@ func TestFilterIterable_Sort(t *testing.T) {
 	}).ToSlice()
 	require.Equal(t, []int{2, 3}, res)
 }
+
+func TestFilterIterable_Chained(t *testing.T) {
+	itr := New([]int{1, 2, 3, 4, 5, 6}).
+		Filter(func(v int) bool {
+			return v%2 == 0
+		}).
+		Filter(func(v int) bool {
+			return v > 2
+		})
+	var res []int
+	itr.For(func(v int, i int) {
+		res = append(res, v)
+	})
+	require.Equal(t, []int{4, 6}, res)
+}
@@ -0,0 +1,100 @@
+package iterable
+
+type funcIterable[T any] struct {
+	next func() (T, bool)
+	cur  T
+	ok   bool
+	done bool
+}
+
+func (v *funcIterable[T]) HasNext() bool {
+	if v.ok {
+		return true
+	}
+	if v.done {
+		return false
+	}
+
+	v.cur, v.ok = v.next()
+	if !v.ok {
+		v.done = true
+	}
+	return v.ok
+}
+
+func (v *funcIterable[T]) Next() T {
+	return mustNext[T](v)
+}
+
+func (v *funcIterable[T]) TryNext() (T, bool) {
+	var zero T
+	if v.ok {
+		res := v.cur
+		v.cur = zero
+		v.ok = false
+		return res, true
+	}
+	if v.done {
+		return zero, false
+	}
+	res, ok := v.next()
+	if !ok {
+		v.done = true
+		return zero, false
+	}
+	return res, true
+}
+
+func (v *funcIterable[T]) Filter(f func(v T) bool) Iterable[T] {
+	return newFilter[T](v, f)
+}
+
+func (v *funcIterable[T]) For(f func(v T, i int)) {
+	doFor[T](v, f)
+}
+
+func (v *funcIterable[T]) All(f func(v T) bool) bool {
+	return all[T](v, f)
+}
+
+func (v *funcIterable[T]) Any(f func(v T) bool) bool {
+	return doAny[T](v, f)
+}
+
+func (v *funcIterable[T]) Reduce(f func(acc T, v T) T) (T, bool) {
+	return reduce[T](v, f)
+}
+
+func (v *funcIterable[T]) Count() int {
+	return count[T](v)
+}
+
+func (v *funcIterable[T]) Sort(less func(a T, b T) bool) Iterable[T] {
+	return doSort[T](v, less)
+}
+
+func (v *funcIterable[T]) Cycle() Iterable[T] {
+	return cycle[T](v)
+}
+
+func (v *funcIterable[T]) Reverse() Iterable[T] {
+	return reverse[T](v)
+}
+
+func (v *funcIterable[T]) Last() (T, bool) {
+	return last[T](v)
+}
+
+func (v *funcIterable[T]) TakeLast(n int) Iterable[T] {
+	return takeLast[T](v, n)
+}
+
+func (v *funcIterable[T]) ToSlice() []T {
+	return toSlice[T](v)
+}
+
+// FromFunc returns an Iterable that pulls its elements from next until next
+// reports false.
+func FromFunc[T any](next func() (T, bool)) Iterable[T] {
+	return &funcIterable[T]{next: next}
+}
@@ -1,10 +1,23 @@
 package iterable
 
+import "errors"
+
+// ErrExhausted is the value Next and NextBack panic with when no elements
+// are left. Use HasNext or TryNext to check first.
+var ErrExhausted = errors.New("iterable: no more elements")
+
 type Iterable[T any] interface {
 	HasNext() bool
 
+	// Next returns the next element. It panics with ErrExhausted if there
+	// are none left.
 	Next() T
 
+	// TryNext returns the next element and true, or the zero value and
+	// false if there are none left. It is equivalent to HasNext followed
+	// by Next in a single call.
+	TryNext() (T, bool)
+
 	Filter(f func(v T) bool) Iterable[T]
 
 	For(func(v T, i int))
@@ -15,10 +28,18 @@ type Iterable[T any] interface {
 
 	Reduce(f func(acc T, v T) T) (T, bool)
 
+	Count() int
+
 	Sort(less func(a T, b T) bool) Iterable[T]
 
 	Cycle() Iterable[T]
 
+	Reverse() Iterable[T]
+
+	Last() (T, bool)
+
+	TakeLast(n int) Iterable[T]
+
 	ToSlice() []T
 }
 
@@ -37,17 +58,21 @@ func (v *Slice[T]) HasNext() bool {
 }
 
 func (v *Slice[T]) Next() T {
+	return mustNext[T](v)
+}
+
+func (v *Slice[T]) TryNext() (T, bool) {
+	if v.i >= len(v.slice) {
+		var zero T
+		return zero, false
+	}
 	res := v.slice[v.i]
 	v.i++
-	return res
+	return res, true
 }
 
 func (v *Slice[T]) Filter(f func(v T) bool) Iterable[T] {
-	return &filterIterable[T]{
-		v,
-		f,
-		nil,
-	}
+	return newFilter[T](v, f)
 }
 
 func (v *Slice[T]) For(f func(v T, i int)) {
@@ -66,16 +91,55 @@ func (v *Slice[T]) Reduce(f func(acc T, v T) T) (T, bool) {
 	return reduce[T](v, f)
 }
 
+func (v *Slice[T]) Count() int {
+	res := len(v.slice) - v.i
+	v.i = len(v.slice)
+	return res
+}
+
 func (v *Slice[T]) Sort(less func(a T, b T) bool) Iterable[T] {
 	return doSort[T](v, less)
 }
 
 func (v *Slice[T]) Cycle() Iterable[T] {
-	return &cycleIterable[T]{v}
+	return newCycle[T](v, -1)
+}
+
+func (v *Slice[T]) NextBack() T {
+	if v.i >= len(v.slice) {
+		panic(ErrExhausted)
+	}
+	last := len(v.slice) - 1
+	res := v.slice[last]
+	v.slice = v.slice[:last]
+	return res
+}
+
+func (v *Slice[T]) Reverse() Iterable[T] {
+	return reverse[T](v)
+}
+
+func (v *Slice[T]) Last() (T, bool) {
+	return last[T](v)
+}
+
+func (v *Slice[T]) TakeLast(n int) Iterable[T] {
+	if n < 0 {
+		n = 0
+	}
+	start := len(v.slice) - n
+	if start < v.i {
+		start = v.i
+	}
+	res := v.slice[start:]
+	v.slice = v.slice[:start]
+	return New(res)
 }
 
 func (v *Slice[T]) ToSlice() []T {
-	return v.slice
+	res := v.slice[v.i:]
+	v.i = len(v.slice)
+	return res
 }
 
 func New[T any](slice []T) Iterable[T] {
@@ -83,13 +147,16 @@ func New[T any](slice []T) Iterable[T] {
 }
 
 func Map[T any, U any](v Iterable[T], f func(v T) U) Iterable[U] {
-	return &mapIterable[T, U]{v, f}
+	res := &mapIterable[T, U]{v, f}
+	if de, ok := v.(DoubleEnded[T]); ok {
+		return &deMapIterable[T, U]{res, de}
+	}
+	return res
 }
 
 func Fold[T any, U any](v Iterable[T], f func(acc U, v T) U, initial U) U {
 	res := initial
-	for v.HasNext() {
-		n := v.Next()
+	for n, ok := v.TryNext(); ok; n, ok = v.TryNext() {
 		res = f(res, n)
 	}
 	return res
@@ -97,6 +164,12 @@ func Fold[T any, U any](v Iterable[T], f func(acc U, v T) U, initial U) U {
 
 func Zip[A any, B any](aIt Iterable[A], bIt Iterable[B]) Iterable[Tuple[A, B]] {
 	var res []Tuple[A, B]
+	if n, ok := sizeHint(aIt); ok && n > 0 {
+		if m, ok := sizeHint(bIt); ok && m < n {
+			n = m
+		}
+		res = make([]Tuple[A, B], 0, n)
+	}
 	for aIt.HasNext() && bIt.HasNext() {
 		res = append(res, Tuple[A, B]{aIt.Next(), bIt.Next()})
 	}
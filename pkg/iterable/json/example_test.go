@@ -0,0 +1,29 @@
+package json_test
+
+import (
+	"fmt"
+	"github.com/sergeychunayev/gofu/pkg/iterable"
+	"github.com/sergeychunayev/gofu/pkg/iterable/json"
+	"os"
+	"strings"
+)
+
+func ExampleDecodeLines() {
+	itr, errF := json.DecodeLines[map[string]int](strings.NewReader("{\"a\":1}\n{\"b\":2}\n"))
+	itr.For(func(v map[string]int, _ int) {
+		fmt.Println(v)
+	})
+	fmt.Println(errF())
+	// Output:
+	// map[a:1]
+	// map[b:2]
+	// <nil>
+}
+
+func ExampleEncodeArray() {
+	itr := iterable.Map(iterable.New([]int{1, 2, 3}), func(v int) int {
+		return v * v
+	})
+	_ = json.EncodeArray(itr, os.Stdout)
+	// Output: [1,4,9]
+}
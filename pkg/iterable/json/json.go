@@ -0,0 +1,120 @@
+package json
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"github.com/sergeychunayev/gofu/pkg/iterable"
+	"io"
+)
+
+// DecodeLines lazily decodes a stream of JSON values, such as JSON Lines,
+// from r. The returned func reports the error that stopped the iteration,
+// if any.
+func DecodeLines[T any](r io.Reader) (iterable.Iterable[T], func() error) {
+	dec := json.NewDecoder(r)
+	var err error
+	next := func() (T, bool) {
+		var res T
+		if err != nil {
+			return res, false
+		}
+		if e := dec.Decode(&res); e != nil {
+			if e != io.EOF {
+				err = e
+			}
+			return res, false
+		}
+		return res, true
+	}
+	return iterable.FromFunc(next), func() error {
+		return err
+	}
+}
+
+// DecodeArray lazily decodes the elements of a top-level JSON array from r.
+// The returned func reports the error that stopped the iteration, if any.
+func DecodeArray[T any](r io.Reader) (iterable.Iterable[T], func() error) {
+	dec := json.NewDecoder(r)
+	var err error
+	started := false
+	done := false
+	next := func() (T, bool) {
+		var res T
+		if err != nil || done {
+			return res, false
+		}
+		if !started {
+			started = true
+			if err = expectDelim(dec, '['); err != nil {
+				return res, false
+			}
+		}
+		if !dec.More() {
+			done = true
+			err = expectDelim(dec, ']')
+			return res, false
+		}
+		if err = dec.Decode(&res); err != nil {
+			return res, false
+		}
+		return res, true
+	}
+	return iterable.FromFunc(next), func() error {
+		return err
+	}
+}
+
+func expectDelim(dec *json.Decoder, delim json.Delim) error {
+	tok, err := dec.Token()
+	if err == io.EOF {
+		return io.ErrUnexpectedEOF
+	}
+	if err != nil {
+		return err
+	}
+	if tok != delim {
+		return fmt.Errorf("json: expected %v, got %v", delim, tok)
+	}
+	return nil
+}
+
+// EncodeLines writes every element of it to w as JSON Lines.
+func EncodeLines[T any](it iterable.Iterable[T], w io.Writer) error {
+	bw := bufio.NewWriter(w)
+	enc := json.NewEncoder(bw)
+	for v, ok := it.TryNext(); ok; v, ok = it.TryNext() {
+		if err := enc.Encode(v); err != nil {
+			return err
+		}
+	}
+	return bw.Flush()
+}
+
+// EncodeArray writes the elements of it to w as a single JSON array.
+func EncodeArray[T any](it iterable.Iterable[T], w io.Writer) error {
+	bw := bufio.NewWriter(w)
+	if err := bw.WriteByte('['); err != nil {
+		return err
+	}
+	i := 0
+	for v, ok := it.TryNext(); ok; v, ok = it.TryNext() {
+		b, err := json.Marshal(v)
+		if err != nil {
+			return err
+		}
+		if i > 0 {
+			if err := bw.WriteByte(','); err != nil {
+				return err
+			}
+		}
+		if _, err := bw.Write(b); err != nil {
+			return err
+		}
+		i++
+	}
+	if err := bw.WriteByte(']'); err != nil {
+		return err
+	}
+	return bw.Flush()
+}
@@ -0,0 +1,136 @@
+package json_test
+
+import (
+	"bytes"
+	"github.com/sergeychunayev/gofu/pkg/iterable"
+	"github.com/sergeychunayev/gofu/pkg/iterable/json"
+	"github.com/stretchr/testify/require"
+	"io"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+type s struct {
+	Name  string `json:"name"`
+	Value int    `json:"value"`
+}
+
+func TestDecodeLines(t *testing.T) {
+	testCases := []struct {
+		name     string
+		input    string
+		expected []s
+	}{
+		{"Empty", "", nil},
+		{"One", `{"name":"a","value":1}`, []s{{"a", 1}}},
+		{"Many", "{\"name\":\"a\",\"value\":1}\n{\"name\":\"b\",\"value\":2}\n", []s{{"a", 1}, {"b", 2}}},
+		{"Blank lines", "\n{\"name\":\"a\"}\n\n{\"value\":2}\n\n", []s{{"a", 0}, {"", 2}}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			itr, errF := json.DecodeLines[s](strings.NewReader(tc.input))
+			require.Equal(t, tc.expected, itr.ToSlice())
+			require.NoError(t, errF())
+		})
+	}
+}
+
+func TestDecodeLines_Err(t *testing.T) {
+	itr, errF := json.DecodeLines[s](strings.NewReader("{\"name\":\"a\"}\n{\"name\":1}\n{\"name\":\"c\"}\n"))
+	require.Equal(t, []s{{"a", 0}}, itr.ToSlice())
+	require.Error(t, errF())
+}
+
+func TestDecodeArray(t *testing.T) {
+	testCases := []struct {
+		name     string
+		input    string
+		expected []s
+	}{
+		{"Empty", "[]", nil},
+		{"One", `[{"name":"a","value":1}]`, []s{{"a", 1}}},
+		{"Many", " [ {\"name\":\"a\",\"value\":1},\n {\"name\":\"b\",\"value\":2} ] ", []s{{"a", 1}, {"b", 2}}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			itr, errF := json.DecodeArray[s](iotest.OneByteReader(strings.NewReader(tc.input)))
+			require.Equal(t, tc.expected, itr.ToSlice())
+			require.NoError(t, errF())
+		})
+	}
+}
+
+func TestDecodeArray_Lazy(t *testing.T) {
+	r := strings.NewReader(`[1, 2, 3, 4, 5, 6, 7, 8, 9, 10]`)
+	itr, _ := json.DecodeArray[int](iotest.OneByteReader(r))
+	require.True(t, itr.HasNext())
+	require.Equal(t, 1, itr.Next())
+	require.NotZero(t, r.Len())
+}
+
+func TestDecodeArray_Err(t *testing.T) {
+	testCases := []struct {
+		name     string
+		input    string
+		expected []int
+		err      error
+	}{
+		{"Empty input", "", nil, io.ErrUnexpectedEOF},
+		{"Not an array", `{"a":1}`, nil, nil},
+		{"Bad element", `[1, "2", 3]`, []int{1}, nil},
+		{"Truncated", `[1, 2`, []int{1, 2}, nil},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			itr, errF := json.DecodeArray[int](strings.NewReader(tc.input))
+			require.Equal(t, tc.expected, itr.ToSlice())
+			require.Error(t, errF())
+			if tc.err != nil {
+				require.ErrorIs(t, errF(), tc.err)
+			}
+		})
+	}
+}
+
+func TestEncodeLines(t *testing.T) {
+	var buf bytes.Buffer
+	err := json.EncodeLines(iterable.New([]s{{"a", 1}, {"b", 2}}), &buf)
+	require.NoError(t, err)
+	require.Equal(t, "{\"name\":\"a\",\"value\":1}\n{\"name\":\"b\",\"value\":2}\n", buf.String())
+}
+
+func TestEncodeArray(t *testing.T) {
+	testCases := []struct {
+		name     string
+		input    []s
+		expected string
+	}{
+		{"Empty", nil, "[]"},
+		{"One", []s{{"a", 1}}, `[{"name":"a","value":1}]`},
+		{"Many", []s{{"a", 1}, {"b", 2}}, `[{"name":"a","value":1},{"name":"b","value":2}]`},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			err := json.EncodeArray(iterable.New(tc.input), &buf)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, buf.String())
+		})
+	}
+}
+
+func TestEncodeArray_Err(t *testing.T) {
+	var buf bytes.Buffer
+	err := json.EncodeArray(iterable.New([]func(){func() {}}), &buf)
+	require.Error(t, err)
+}
+
+func TestRoundTrip(t *testing.T) {
+	input := []s{{"a", 1}, {"b", 2}}
+	var buf bytes.Buffer
+	require.NoError(t, json.EncodeArray(iterable.New(input), &buf))
+	itr, errF := json.DecodeArray[s](&buf)
+	require.Equal(t, input, itr.ToSlice())
+	require.NoError(t, errF())
+}
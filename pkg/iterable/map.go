@@ -5,6 +5,12 @@ type mapIterable[T any, U any] struct {
 	mapF func(v T) U
 }
 
+// deMapIterable is a mapIterable over a DoubleEnded source.
+type deMapIterable[T any, U any] struct {
+	*mapIterable[T, U]
+	back DoubleEnded[T]
+}
+
 func (v *mapIterable[T, U]) HasNext() bool {
 	return v.itr.HasNext()
 }
@@ -13,12 +19,17 @@ func (v *mapIterable[T, U]) Next() U {
 	return v.mapF(v.itr.Next())
 }
 
-func (v *mapIterable[T, U]) Filter(f func(v U) bool) Iterable[U] {
-	return &filterIterable[U]{
-		v,
-		f,
-		nil,
+func (v *mapIterable[T, U]) TryNext() (U, bool) {
+	el, ok := v.itr.TryNext()
+	if !ok {
+		var zero U
+		return zero, false
 	}
+	return v.mapF(el), true
+}
+
+func (v *mapIterable[T, U]) Filter(f func(v U) bool) Iterable[U] {
+	return newFilter[U](v, f)
 }
 
 func (v *mapIterable[T, U]) For(f func(v U, i int)) {
@@ -37,6 +48,10 @@ func (v *mapIterable[T, U]) Reduce(f func(acc U, v U) U) (U, bool) {
 	return reduce[U](v, f)
 }
 
+func (v *mapIterable[T, U]) Count() int {
+	return count[U](v)
+}
+
 func (v *mapIterable[T, U]) Sort(less func(a U, b U) bool) Iterable[U] {
 	return doSort[U](v, less)
 }
@@ -45,6 +60,38 @@ func (v *mapIterable[T, U]) Cycle() Iterable[U] {
 	return cycle[U](v)
 }
 
+func (v *mapIterable[T, U]) Reverse() Iterable[U] {
+	return reverse[U](v)
+}
+
+func (v *mapIterable[T, U]) Last() (U, bool) {
+	return last[U](v)
+}
+
+func (v *mapIterable[T, U]) TakeLast(n int) Iterable[U] {
+	return takeLast[U](v, n)
+}
+
 func (v *mapIterable[T, U]) ToSlice() []U {
 	return toSlice[U](v)
 }
+
+func (v *deMapIterable[T, U]) NextBack() U {
+	return v.mapF(v.back.NextBack())
+}
+
+func (v *deMapIterable[T, U]) Filter(f func(v U) bool) Iterable[U] {
+	return newFilter[U](v, f)
+}
+
+func (v *deMapIterable[T, U]) Reverse() Iterable[U] {
+	return reverse[U](v)
+}
+
+func (v *deMapIterable[T, U]) Last() (U, bool) {
+	return last[U](v)
+}
+
+func (v *deMapIterable[T, U]) TakeLast(n int) Iterable[U] {
+	return takeLast[U](v, n)
+}
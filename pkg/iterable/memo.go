@@ -0,0 +1,49 @@
+package iterable
+
+import "sync"
+
+// Memo caches the elements of an Iterable as they are first pulled so that
+// they can be iterated any number of times.
+type Memo[T any] struct {
+	mu    sync.Mutex
+	src   Iterable[T]
+	cache []T
+	done  bool
+}
+
+// Memoize returns a Memo over it. it must not be used afterwards.
+func Memoize[T any](it Iterable[T]) *Memo[T] {
+	return &Memo[T]{src: it}
+}
+
+func (m *Memo[T]) get(i int) (T, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if i == len(m.cache) && !m.done {
+		if v, ok := m.src.TryNext(); ok {
+			m.cache = append(m.cache, v)
+		} else {
+			m.done = true
+			m.src = nil
+		}
+	}
+	if i < len(m.cache) {
+		return m.cache[i], true
+	}
+	var res T
+	return res, false
+}
+
+// Iter returns a fresh Iterable starting from the first element. Elements
+// not cached yet are pulled from the source on demand.
+func (m *Memo[T]) Iter() Iterable[T] {
+	i := 0
+	return FromFunc(func() (T, bool) {
+		res, ok := m.get(i)
+		if ok {
+			i++
+		}
+		return res, ok
+	})
+}
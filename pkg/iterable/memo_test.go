@@ -0,0 +1,59 @@
+package iterable_test
+
+import (
+	"github.com/sergeychunayev/gofu/pkg/iterable"
+	"github.com/stretchr/testify/require"
+	"sync"
+	"testing"
+)
+
+func TestMemoize(t *testing.T) {
+	pulled := 0
+	memo := iterable.Memoize(counting([]int{1, 2, 3}, &pulled))
+	require.Zero(t, pulled)
+
+	first := memo.Iter()
+	require.Equal(t, 1, first.Next())
+	require.Equal(t, 1, pulled)
+
+	require.Equal(t, []int{1, 2, 3}, memo.Iter().ToSlice())
+	require.Equal(t, 3, pulled)
+
+	require.Equal(t, []int{2, 3}, first.ToSlice())
+	require.Equal(t, []int{1, 2, 3}, memo.Iter().ToSlice())
+	require.Equal(t, 3, pulled)
+}
+
+func TestMemoize_Interleaved(t *testing.T) {
+	memo := iterable.Memoize(iterable.New([]int{1, 2, 3}))
+	a := memo.Iter()
+	b := memo.Iter()
+	var res []int
+	for a.HasNext() && b.HasNext() {
+		res = append(res, a.Next(), b.Next())
+	}
+	require.Equal(t, []int{1, 1, 2, 2, 3, 3}, res)
+}
+
+func TestMemoize_Empty(t *testing.T) {
+	memo := iterable.Memoize(iterable.New([]int{}))
+	require.False(t, memo.Iter().HasNext())
+	require.False(t, memo.Iter().HasNext())
+}
+
+func TestMemoize_Concurrent(t *testing.T) {
+	arr := make([]int, 1000)
+	for i := range arr {
+		arr[i] = i
+	}
+	memo := iterable.Memoize(iterable.New(arr))
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			require.Equal(t, arr, memo.Iter().ToSlice())
+		}()
+	}
+	wg.Wait()
+}
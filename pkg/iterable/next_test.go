@@ -0,0 +1,122 @@
+package iterable_test
+
+import (
+	"github.com/sergeychunayev/gofu/pkg/iterable"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+// iterables returns one Iterable of every kind over the elements 2 and 4.
+func iterables() map[string]iterable.Iterable[int] {
+	ch := make(chan int, 2)
+	ch <- 2
+	ch <- 4
+	close(ch)
+	n := 0
+	evens := func() (int, bool) {
+		n += 2
+		return n, n <= 4
+	}
+	return map[string]iterable.Iterable[int]{
+		"Slice":         iterable.New([]int{2, 4}),
+		"Filter":        iterable.New([]int{1, 2, 3, 4, 5}).Filter(isEven),
+		"Filter single": upTo(5).Filter(isEven),
+		"Map":           iterable.Map(iterable.New([]int{1, 2}), double),
+		"Map single":    iterable.Map(upTo(2), double),
+		"Reverse":       iterable.New([]int{4, 2}).Reverse(),
+		"Func":          iterable.FromFunc(evens),
+		"Chan":          iterable.FromChan(ch),
+		"TakeLast":      iterable.New([]int{1, 2, 4}).TakeLast(2),
+		"Memo":          iterable.Memoize(iterable.New([]int{2, 4})).Iter(),
+		"Tee":           iterable.Tee(iterable.New([]int{2, 4}), 1)[0],
+	}
+}
+
+func TestTryNext(t *testing.T) {
+	for name, it := range iterables() {
+		t.Run(name, func(t *testing.T) {
+			var res []int
+			for v, ok := it.TryNext(); ok; v, ok = it.TryNext() {
+				res = append(res, v)
+			}
+			require.Equal(t, []int{2, 4}, res)
+
+			v, ok := it.TryNext()
+			require.False(t, ok)
+			require.Zero(t, v)
+			require.False(t, it.HasNext())
+		})
+	}
+}
+
+func TestTryNext_AfterHasNext(t *testing.T) {
+	for name, it := range iterables() {
+		t.Run(name, func(t *testing.T) {
+			require.True(t, it.HasNext())
+			require.True(t, it.HasNext())
+			v, ok := it.TryNext()
+			require.True(t, ok)
+			require.Equal(t, 2, v)
+			require.Equal(t, 4, it.Next())
+		})
+	}
+}
+
+func TestNext_Exhausted(t *testing.T) {
+	for name, it := range iterables() {
+		t.Run(name, func(t *testing.T) {
+			it.ToSlice()
+			require.PanicsWithError(t, iterable.ErrExhausted.Error(), func() {
+				it.Next()
+			})
+		})
+	}
+}
+
+func TestNextBack_Exhausted(t *testing.T) {
+	testCases := map[string]iterable.Iterable[int]{
+		"Slice":   iterable.New([]int{1}),
+		"Filter":  iterable.New([]int{1, 2}).Filter(isEven),
+		"Map":     iterable.Map(iterable.New([]int{1}), double),
+		"Reverse": iterable.New([]int{1}).Reverse(),
+	}
+	for name, it := range testCases {
+		t.Run(name, func(t *testing.T) {
+			de := it.(iterable.DoubleEnded[int])
+			de.NextBack()
+			require.PanicsWithError(t, iterable.ErrExhausted.Error(), func() {
+				de.NextBack()
+			})
+		})
+	}
+}
+
+func TestCycle_Empty(t *testing.T) {
+	it := iterable.New[int](nil).Cycle()
+	_, ok := it.TryNext()
+	require.False(t, ok)
+	require.PanicsWithError(t, iterable.ErrExhausted.Error(), func() {
+		it.Next()
+	})
+}
+
+func BenchmarkFilter_HasNextNext(b *testing.B) {
+	arr := make([]int, 1000)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		it := iterable.New(arr).Filter(isEven)
+		for it.HasNext() {
+			it.Next()
+		}
+	}
+}
+
+func BenchmarkFilter_TryNext(b *testing.B) {
+	arr := make([]int, 1000)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		it := iterable.New(arr).Filter(isEven)
+		for _, ok := it.TryNext(); ok; _, ok = it.TryNext() {
+		}
+	}
+}
@@ -0,0 +1,87 @@
+package pipeline
+
+import (
+	"context"
+	"github.com/sergeychunayev/gofu/pkg/iterable"
+)
+
+// Apply returns an Iterable over the result of running p on the elements of
+// it. Elements are pulled from it lazily, only as many as needed to produce
+// the next result.
+func Apply[T any, U any](p Pipeline[T, U], it iterable.Iterable[T]) iterable.Iterable[U] {
+	var buf []U
+	head := 0
+	s := p.build(func(v U) bool {
+		buf = append(buf, v)
+		return true
+	})
+	done := false
+	next := func() (U, bool) {
+		var zero U
+		for head == len(buf) && !done {
+			if v, ok := it.TryNext(); !ok || !s.push(v) {
+				done = true
+				s.flush()
+			}
+		}
+		if head == len(buf) {
+			return zero, false
+		}
+		res := buf[head]
+		buf[head] = zero
+		head++
+		if head == len(buf) {
+			buf = buf[:0]
+			head = 0
+		}
+		return res, true
+	}
+	return iterable.FromFunc(next)
+}
+
+// Fold runs p on the elements of it and combines the results with f,
+// starting from init.
+func Fold[T any, U any, A any](p Pipeline[T, U], it iterable.Iterable[T], init A, f func(acc A, v U) A) A {
+	acc := init
+	s := p.build(func(v U) bool {
+		acc = f(acc, v)
+		return true
+	})
+	for v, ok := it.TryNext(); ok; v, ok = it.TryNext() {
+		if !s.push(v) {
+			break
+		}
+	}
+	s.flush()
+	return acc
+}
+
+// ApplyChan runs p on the values received from in and sends the results to
+// the returned channel. The channel is closed once in is closed, p stops
+// accepting input or ctx is done; in is not drained in the latter cases.
+func ApplyChan[T any, U any](ctx context.Context, p Pipeline[T, U], in <-chan T) <-chan U {
+	res := make(chan U)
+	s := p.build(func(v U) bool {
+		select {
+		case res <- v:
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	})
+	go func() {
+		defer close(res)
+		defer s.flush()
+		for {
+			select {
+			case v, ok := <-in:
+				if !ok || !s.push(v) {
+					return
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return res
+}
@@ -0,0 +1,35 @@
+package pipeline_test
+
+import (
+	"fmt"
+	"github.com/sergeychunayev/gofu/pkg/iterable"
+	"github.com/sergeychunayev/gofu/pkg/iterable/pipeline"
+	"strings"
+)
+
+type user struct {
+	name   string
+	active bool
+}
+
+func Example() {
+	activeNames := pipeline.Distinct(pipeline.Map(
+		pipeline.New[user]().Filter(func(u user) bool {
+			return u.active
+		}),
+		func(u user) string {
+			return strings.ToLower(u.name)
+		},
+	))
+
+	users := []user{{"Ann", true}, {"Bob", false}, {"ann", true}, {"Cid", true}}
+	fmt.Println(pipeline.Apply(activeNames, iterable.New(users)).ToSlice())
+
+	count := pipeline.Fold(activeNames, iterable.New(users), 0, func(acc int, _ string) int {
+		return acc + 1
+	})
+	fmt.Println(count)
+	// Output:
+	// [ann cid]
+	// 2
+}
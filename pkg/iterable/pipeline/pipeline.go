@@ -0,0 +1,200 @@
+package pipeline
+
+// Pipeline is a reusable sequence of processing stages that turns Ts into
+// Us. It does not depend on where the Ts come from: the same Pipeline can be
+// applied to an Iterable with Apply, to a channel with ApplyChan or to a
+// reducer with Fold, any number of times. All stages run fused in a single
+// pass, element by element, without intermediate collections.
+//
+// The zero value is not usable; start from New.
+type Pipeline[T any, U any] struct {
+	build func(emit func(v U) bool) stage[T]
+}
+
+// stage is one instantiation of a Pipeline. push feeds it an element and
+// reports whether it accepts more; flush signals the end of the input so
+// that buffered elements can be emitted. Every application builds fresh
+// stages, so stateful stages such as Take and Distinct start over.
+type stage[T any] struct {
+	push  func(v T) bool
+	flush func() bool
+}
+
+func noFlush() bool {
+	return true
+}
+
+// New returns a Pipeline that passes Ts through unchanged.
+func New[T any]() Pipeline[T, T] {
+	return Pipeline[T, T]{func(emit func(v T) bool) stage[T] {
+		return stage[T]{emit, noFlush}
+	}}
+}
+
+// Compose returns a Pipeline that runs p and then q.
+func Compose[T any, U any, V any](p Pipeline[T, U], q Pipeline[U, V]) Pipeline[T, V] {
+	return then(p, q.build)
+}
+
+func then[T any, U any, V any](p Pipeline[T, U], next func(emit func(v V) bool) stage[U]) Pipeline[T, V] {
+	return Pipeline[T, V]{func(emit func(v V) bool) stage[T] {
+		n := next(emit)
+		s := p.build(n.push)
+		return stage[T]{s.push, func() bool {
+			// n may still hold buffered elements even if it rejected the ones
+			// flushed by s, so it is always flushed too.
+			ok := s.flush()
+			return n.flush() && ok
+		}}
+	}}
+}
+
+// Filter keeps the elements for which f returns true.
+func (p Pipeline[T, U]) Filter(f func(v U) bool) Pipeline[T, U] {
+	return then(p, func(emit func(v U) bool) stage[U] {
+		return stage[U]{func(v U) bool {
+			if f(v) {
+				return emit(v)
+			}
+			return true
+		}, noFlush}
+	})
+}
+
+// Take keeps the first n elements and then stops the input.
+func (p Pipeline[T, U]) Take(n int) Pipeline[T, U] {
+	return then(p, func(emit func(v U) bool) stage[U] {
+		i := 0
+		return stage[U]{func(v U) bool {
+			if i >= n {
+				return false
+			}
+			i++
+			return emit(v) && i < n
+		}, noFlush}
+	})
+}
+
+// Drop skips the first n elements.
+func (p Pipeline[T, U]) Drop(n int) Pipeline[T, U] {
+	return then(p, func(emit func(v U) bool) stage[U] {
+		i := 0
+		return stage[U]{func(v U) bool {
+			if i < n {
+				i++
+				return true
+			}
+			return emit(v)
+		}, noFlush}
+	})
+}
+
+// TakeWhile keeps elements while f returns true and then stops the input.
+func (p Pipeline[T, U]) TakeWhile(f func(v U) bool) Pipeline[T, U] {
+	return then(p, func(emit func(v U) bool) stage[U] {
+		done := false
+		return stage[U]{func(v U) bool {
+			if done || !f(v) {
+				done = true
+				return false
+			}
+			return emit(v)
+		}, noFlush}
+	})
+}
+
+// DropWhile skips elements while f returns true.
+func (p Pipeline[T, U]) DropWhile(f func(v U) bool) Pipeline[T, U] {
+	return then(p, func(emit func(v U) bool) stage[U] {
+		dropping := true
+		return stage[U]{func(v U) bool {
+			if dropping && f(v) {
+				return true
+			}
+			dropping = false
+			return emit(v)
+		}, noFlush}
+	})
+}
+
+// Tap calls f for every element and passes it on unchanged.
+func (p Pipeline[T, U]) Tap(f func(v U)) Pipeline[T, U] {
+	return then(p, func(emit func(v U) bool) stage[U] {
+		return stage[U]{func(v U) bool {
+			f(v)
+			return emit(v)
+		}, noFlush}
+	})
+}
+
+// Map converts every element with f.
+func Map[T any, U any, V any](p Pipeline[T, U], f func(v U) V) Pipeline[T, V] {
+	return then(p, func(emit func(v V) bool) stage[U] {
+		return stage[U]{func(v U) bool {
+			return emit(f(v))
+		}, noFlush}
+	})
+}
+
+// FlatMap replaces every element with the elements returned by f.
+func FlatMap[T any, U any, V any](p Pipeline[T, U], f func(v U) []V) Pipeline[T, V] {
+	return then(p, func(emit func(v V) bool) stage[U] {
+		return stage[U]{func(v U) bool {
+			for _, r := range f(v) {
+				if !emit(r) {
+					return false
+				}
+			}
+			return true
+		}, noFlush}
+	})
+}
+
+// Chunk groups elements into slices of size n. The last slice holds the
+// remaining elements and may be shorter. Panics if n <= 0.
+func Chunk[T any, U any](p Pipeline[T, U], n int) Pipeline[T, []U] {
+	if n <= 0 {
+		panic("pipeline: chunk size must be positive")
+	}
+	return then(p, func(emit func(v []U) bool) stage[U] {
+		var buf []U
+		return stage[U]{func(v U) bool {
+			buf = append(buf, v)
+			if len(buf) < n {
+				return true
+			}
+			res := buf
+			buf = nil
+			return emit(res)
+		}, func() bool {
+			if len(buf) == 0 {
+				return true
+			}
+			res := buf
+			buf = nil
+			return emit(res)
+		}}
+	})
+}
+
+// DistinctBy keeps the first element for every key returned by keyF.
+func DistinctBy[T any, U any, K comparable](p Pipeline[T, U], keyF func(v U) K) Pipeline[T, U] {
+	return then(p, func(emit func(v U) bool) stage[U] {
+		seen := make(map[K]struct{})
+		return stage[U]{func(v U) bool {
+			k := keyF(v)
+			if _, ok := seen[k]; ok {
+				return true
+			}
+			seen[k] = struct{}{}
+			return emit(v)
+		}, noFlush}
+	})
+}
+
+// Distinct keeps the first occurrence of every element.
+func Distinct[T any, U comparable](p Pipeline[T, U]) Pipeline[T, U] {
+	return DistinctBy(p, func(v U) U {
+		return v
+	})
+}
@@ -0,0 +1,167 @@
+package pipeline
+
+import (
+	"context"
+	"github.com/sergeychunayev/gofu/pkg/iterable"
+	"github.com/stretchr/testify/require"
+	"strconv"
+	"testing"
+)
+
+func isEven(v int) bool {
+	return v%2 == 0
+}
+
+func upTo(n int) []int {
+	res := make([]int, n)
+	for i := range res {
+		res[i] = i + 1
+	}
+	return res
+}
+
+func TestPipeline(t *testing.T) {
+	testCases := []struct {
+		name     string
+		p        Pipeline[int, int]
+		input    []int
+		expected []int
+	}{
+		{"Identity", New[int](), upTo(3), []int{1, 2, 3}},
+		{"Filter", New[int]().Filter(isEven), upTo(5), []int{2, 4}},
+		{"Take", New[int]().Take(2), upTo(5), []int{1, 2}},
+		{"Take zero", New[int]().Take(0), upTo(5), nil},
+		{"Take more", New[int]().Take(10), upTo(2), []int{1, 2}},
+		{"Drop", New[int]().Drop(3), upTo(5), []int{4, 5}},
+		{"TakeWhile", New[int]().TakeWhile(func(v int) bool {
+			return v < 3
+		}), []int{1, 2, 3, 1}, []int{1, 2}},
+		{"DropWhile", New[int]().DropWhile(func(v int) bool {
+			return v < 3
+		}), []int{1, 2, 3, 1}, []int{3, 1}},
+		{"Distinct", Distinct(New[int]()), []int{1, 2, 1, 3, 2}, []int{1, 2, 3}},
+		{"Map", Map(New[int](), func(v int) int {
+			return v * 10
+		}), upTo(3), []int{10, 20, 30}},
+		{"FlatMap", FlatMap(New[int](), func(v int) []int {
+			return []int{v, v}
+		}).Take(3), upTo(3), []int{1, 1, 2}},
+		{"Filter then Take", New[int]().Filter(isEven).Take(2), upTo(10), []int{2, 4}},
+		{"Take then Filter", New[int]().Take(2).Filter(isEven), upTo(10), []int{2}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, Apply(tc.p, iterable.New(tc.input)).ToSlice())
+			res := Fold(tc.p, iterable.New(tc.input), []int(nil), func(acc []int, v int) []int {
+				return append(acc, v)
+			})
+			require.Equal(t, tc.expected, res)
+		})
+	}
+}
+
+func TestChunk(t *testing.T) {
+	p := Chunk(New[int](), 2)
+	require.Equal(t, [][]int{{1, 2}, {3, 4}, {5}}, Apply(p, iterable.New(upTo(5))).ToSlice())
+	require.Equal(t, [][]int{{1, 2}}, Apply(p.Take(1), iterable.New(upTo(5))).ToSlice())
+	require.Equal(t, [][]int{{1, 2}, {3}}, Apply(Chunk(New[int]().Take(3), 2), iterable.New(upTo(5))).ToSlice())
+	require.Panics(t, func() {
+		Chunk(New[int](), 0)
+	})
+}
+
+func TestChunk_AfterStop(t *testing.T) {
+	pairs := Chunk(New[int](), 2)
+	testCases := []struct {
+		name     string
+		p        Pipeline[int, [][]int]
+		input    []int
+		expected [][][]int
+	}{
+		{"Take", Chunk(pairs.Take(3), 2), upTo(5), [][][]int{{{1, 2}, {3, 4}}, {{5}}}},
+		{"TakeWhile", Chunk(pairs.TakeWhile(func(v []int) bool {
+			return len(v) == 2
+		}), 2), upTo(7), [][][]int{{{1, 2}, {3, 4}}, {{5, 6}}}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, Apply(tc.p, iterable.New(tc.input)).ToSlice())
+
+			res := Fold(tc.p, iterable.New(tc.input), [][][]int(nil), func(acc [][][]int, v [][]int) [][][]int {
+				return append(acc, v)
+			})
+			require.Equal(t, tc.expected, res)
+
+			in := make(chan int, len(tc.input))
+			for _, v := range tc.input {
+				in <- v
+			}
+			close(in)
+			res = nil
+			for v := range ApplyChan(context.Background(), tc.p, in) {
+				res = append(res, v)
+			}
+			require.Equal(t, tc.expected, res)
+		})
+	}
+}
+
+func TestCompose(t *testing.T) {
+	evens := New[int]().Filter(isEven)
+	toString := Map(New[int](), strconv.Itoa)
+	p := Compose(evens, toString)
+	require.Equal(t, []string{"2", "4"}, Apply(p, iterable.New(upTo(5))).ToSlice())
+}
+
+func TestPipeline_Reusable(t *testing.T) {
+	p := Distinct(New[int]()).Take(2)
+	require.Equal(t, []int{1, 2}, Apply(p, iterable.New([]int{1, 1, 2, 3})).ToSlice())
+	require.Equal(t, []int{1, 2}, Apply(p, iterable.New([]int{1, 2})).ToSlice())
+}
+
+func TestPipeline_SinglePass(t *testing.T) {
+	var trace []string
+	p := Map(New[int]().Tap(func(v int) {
+		trace = append(trace, "a"+strconv.Itoa(v))
+	}).Filter(isEven), func(v int) int {
+		trace = append(trace, "b"+strconv.Itoa(v))
+		return v
+	})
+	it := Apply(p, iterable.New(upTo(4)))
+	require.Nil(t, trace)
+	require.True(t, it.HasNext())
+	require.Equal(t, []string{"a1", "a2", "b2"}, trace)
+	it.ToSlice()
+	require.Equal(t, []string{"a1", "a2", "b2", "a3", "a4", "b4"}, trace)
+}
+
+func TestPipeline_StopsSource(t *testing.T) {
+	src := iterable.New(upTo(10))
+	Apply(New[int]().Take(2), src).ToSlice()
+	require.Equal(t, upTo(10)[2:], src.ToSlice())
+}
+
+func TestApplyChan(t *testing.T) {
+	in := make(chan int)
+	go func() {
+		defer close(in)
+		for _, v := range upTo(5) {
+			in <- v
+		}
+	}()
+	out := ApplyChan(context.Background(), Chunk(New[int]().Filter(isEven), 1), in)
+	var res [][]int
+	for v := range out {
+		res = append(res, v)
+	}
+	require.Equal(t, [][]int{{2}, {4}}, res)
+}
+
+func TestApplyChan_Cancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	in := make(chan int)
+	out := ApplyChan(ctx, New[int](), in)
+	cancel()
+	for range out {
+	}
+}
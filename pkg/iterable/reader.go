@@ -0,0 +1,100 @@
+package iterable
+
+import (
+	"bufio"
+	"io"
+)
+
+func newErrIterable[T any](next func() (T, bool), err *error) (Iterable[T], func() error) {
+	return FromFunc(next), func() error {
+		return *err
+	}
+}
+
+// Scan lazily splits r into tokens using split. The returned func reports the
+// first read error once the iterable is exhausted. Tokens are limited to
+// bufio.MaxScanTokenSize (64 KiB); a longer one ends the iteration with
+// bufio.ErrTooLong. Use ScanBuffer to raise the limit.
+func Scan(r io.Reader, split bufio.SplitFunc) (Iterable[string], func() error) {
+	return ScanBuffer(r, split, bufio.MaxScanTokenSize)
+}
+
+// ScanBuffer is like Scan but allows tokens of up to maxTokenSize bytes.
+func ScanBuffer(r io.Reader, split bufio.SplitFunc, maxTokenSize int) (Iterable[string], func() error) {
+	sc := bufio.NewScanner(r)
+	sc.Split(split)
+	initial := 4096
+	if maxTokenSize < initial {
+		initial = maxTokenSize
+	}
+	sc.Buffer(make([]byte, 0, initial), maxTokenSize)
+	var err error
+	return newErrIterable(func() (string, bool) {
+		if !sc.Scan() {
+			err = sc.Err()
+			return "", false
+		}
+		return sc.Text(), true
+	}, &err)
+}
+
+// Lines lazily reads r line by line, stripping line terminators. Lines are
+// limited to 64 KiB as in Scan; use ScanBuffer with bufio.ScanLines for
+// longer ones.
+func Lines(r io.Reader) (Iterable[string], func() error) {
+	return Scan(r, bufio.ScanLines)
+}
+
+// Words lazily reads space-separated words from r. Words are limited to
+// 64 KiB as in Scan.
+func Words(r io.Reader) (Iterable[string], func() error) {
+	return Scan(r, bufio.ScanWords)
+}
+
+// Chunks lazily reads r in chunks of size bytes. The last chunk may be
+// shorter.
+func Chunks(r io.Reader, size int) (Iterable[[]byte], func() error) {
+	if size <= 0 {
+		panic("iterable: non-positive chunk size")
+	}
+	var err error
+	done := false
+	return newErrIterable(func() ([]byte, bool) {
+		if done {
+			return nil, false
+		}
+		buf := make([]byte, size)
+		n, e := io.ReadFull(r, buf)
+		switch e {
+		case nil:
+			return buf, true
+		case io.EOF:
+			done = true
+			return nil, false
+		case io.ErrUnexpectedEOF:
+			done = true
+			return buf[:n], true
+		default:
+			done = true
+			err = e
+			if n > 0 {
+				return buf[:n], true
+			}
+			return nil, false
+		}
+	}, &err)
+}
+
+// WriteLines writes every element of it to w followed by a newline.
+func WriteLines(it Iterable[string], w io.Writer) error {
+	bw := bufio.NewWriter(w)
+	for v, ok := it.TryNext(); ok; v, ok = it.TryNext() {
+		if _, err := bw.WriteString(v); err != nil {
+			return err
+		}
+		if err := bw.WriteByte('\n'); err != nil {
+			return err
+		}
+	}
+	return bw.Flush()
+}
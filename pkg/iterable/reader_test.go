@@ -0,0 +1,129 @@
+package iterable_test
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"github.com/sergeychunayev/gofu/pkg/iterable"
+	"github.com/stretchr/testify/require"
+	"io"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+func TestLines(t *testing.T) {
+	testCases := []struct {
+		name     string
+		input    string
+		expected []string
+	}{
+		{"Empty", "", nil},
+		{"One", "one", []string{"one"}},
+		{"Trailing newline", "one\ntwo\n", []string{"one", "two"}},
+		{"CRLF", "one\r\ntwo", []string{"one", "two"}},
+		{"Blank lines", "one\n\ntwo", []string{"one", "", "two"}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			itr, errF := iterable.Lines(strings.NewReader(tc.input))
+			require.Equal(t, tc.expected, itr.ToSlice())
+			require.NoError(t, errF())
+		})
+	}
+}
+
+func TestLines_Lazy(t *testing.T) {
+	r := strings.NewReader("one\ntwo\nthree\n")
+	itr, _ := iterable.Lines(iotest.OneByteReader(r))
+	require.True(t, itr.HasNext())
+	require.Equal(t, "one", itr.Next())
+	require.NotZero(t, r.Len())
+}
+
+func TestLines_Err(t *testing.T) {
+	fail := errors.New("fail")
+	r := io.MultiReader(strings.NewReader("one\ntwo\n"), iotest.ErrReader(fail))
+	itr, errF := iterable.Lines(r)
+	require.Equal(t, []string{"one", "two"}, itr.ToSlice())
+	require.ErrorIs(t, errF(), fail)
+}
+
+func TestScan(t *testing.T) {
+	itr, errF := iterable.Scan(strings.NewReader("abc"), bufio.ScanRunes)
+	require.Equal(t, []string{"a", "b", "c"}, itr.ToSlice())
+	require.NoError(t, errF())
+}
+
+func TestLines_TooLong(t *testing.T) {
+	long := strings.Repeat("x", bufio.MaxScanTokenSize+1)
+	itr, errF := iterable.Lines(strings.NewReader("a\n" + long + "\nb\n"))
+	require.Equal(t, []string{"a"}, itr.ToSlice())
+	require.ErrorIs(t, errF(), bufio.ErrTooLong)
+
+	itr, errF = iterable.ScanBuffer(strings.NewReader("a\n"+long+"\nb\n"), bufio.ScanLines, 2*bufio.MaxScanTokenSize)
+	require.Equal(t, []string{"a", long, "b"}, itr.ToSlice())
+	require.NoError(t, errF())
+}
+
+func TestWords(t *testing.T) {
+	itr, errF := iterable.Words(strings.NewReader("  one two\n\tthree "))
+	require.Equal(t, []string{"one", "two", "three"}, itr.ToSlice())
+	require.NoError(t, errF())
+}
+
+func TestChunks(t *testing.T) {
+	testCases := []struct {
+		name     string
+		input    string
+		size     int
+		expected [][]byte
+	}{
+		{"Empty", "", 2, nil},
+		{"Exact", "abcd", 2, [][]byte{[]byte("ab"), []byte("cd")}},
+		{"Partial", "abcde", 2, [][]byte{[]byte("ab"), []byte("cd"), []byte("e")}},
+		{"Larger", "abc", 8, [][]byte{[]byte("abc")}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			itr, errF := iterable.Chunks(iotest.HalfReader(strings.NewReader(tc.input)), tc.size)
+			require.Equal(t, tc.expected, itr.ToSlice())
+			require.NoError(t, errF())
+		})
+	}
+}
+
+func TestChunks_Err(t *testing.T) {
+	fail := errors.New("fail")
+	r := io.MultiReader(strings.NewReader("abc"), iotest.ErrReader(fail))
+	itr, errF := iterable.Chunks(r, 2)
+	require.Equal(t, [][]byte{[]byte("ab"), []byte("c")}, itr.ToSlice())
+	require.ErrorIs(t, errF(), fail)
+}
+
+func TestChunks_InvalidSize(t *testing.T) {
+	require.Panics(t, func() {
+		_, _ = iterable.Chunks(strings.NewReader(""), 0)
+	})
+}
+
+func TestWriteLines(t *testing.T) {
+	var buf bytes.Buffer
+	err := iterable.WriteLines(iterable.New([]string{"one", "two"}), &buf)
+	require.NoError(t, err)
+	require.Equal(t, "one\ntwo\n", buf.String())
+}
+
+func TestWriteLines_Err(t *testing.T) {
+	fail := errors.New("fail")
+	err := iterable.WriteLines(iterable.New([]string{"one"}), errWriter{fail})
+	require.ErrorIs(t, err, fail)
+}
+
+type errWriter struct {
+	err error
+}
+
+func (w errWriter) Write([]byte) (int, error) {
+	return 0, w.err
+}
@@ -0,0 +1,82 @@
+package iterable
+
+// DoubleEnded is an Iterable that can also yield elements from the back.
+// HasNext reports whether any elements are left at either end. NextBack
+// panics with ErrExhausted if there are none.
+type DoubleEnded[T any] interface {
+	Iterable[T]
+
+	NextBack() T
+}
+
+type reverseIterable[T any] struct {
+	itr DoubleEnded[T]
+}
+
+func (v *reverseIterable[T]) HasNext() bool {
+	return v.itr.HasNext()
+}
+
+func (v *reverseIterable[T]) Next() T {
+	return v.itr.NextBack()
+}
+
+func (v *reverseIterable[T]) TryNext() (T, bool) {
+	if !v.itr.HasNext() {
+		var zero T
+		return zero, false
+	}
+	return v.itr.NextBack(), true
+}
+
+func (v *reverseIterable[T]) NextBack() T {
+	return v.itr.Next()
+}
+
+func (v *reverseIterable[T]) Filter(f func(v T) bool) Iterable[T] {
+	return newFilter[T](v, f)
+}
+
+func (v *reverseIterable[T]) For(f func(v T, i int)) {
+	doFor[T](v, f)
+}
+
+func (v *reverseIterable[T]) All(f func(v T) bool) bool {
+	return all[T](v, f)
+}
+
+func (v *reverseIterable[T]) Any(f func(v T) bool) bool {
+	return doAny[T](v, f)
+}
+
+func (v *reverseIterable[T]) Reduce(f func(acc T, v T) T) (T, bool) {
+	return reduce[T](v, f)
+}
+
+func (v *reverseIterable[T]) Count() int {
+	return count[T](v)
+}
+
+func (v *reverseIterable[T]) Sort(less func(a T, b T) bool) Iterable[T] {
+	return doSort[T](v, less)
+}
+
+func (v *reverseIterable[T]) Cycle() Iterable[T] {
+	return cycle[T](v)
+}
+
+func (v *reverseIterable[T]) Reverse() Iterable[T] {
+	return v.itr
+}
+
+func (v *reverseIterable[T]) Last() (T, bool) {
+	return last[T](v)
+}
+
+func (v *reverseIterable[T]) TakeLast(n int) Iterable[T] {
+	return takeLast[T](v, n)
+}
+
+func (v *reverseIterable[T]) ToSlice() []T {
+	return toSlice[T](v)
+}
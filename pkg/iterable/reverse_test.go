@@ -0,0 +1,168 @@
+package iterable_test
+
+import (
+	"fmt"
+	"github.com/sergeychunayev/gofu/pkg/iterable"
+	"github.com/stretchr/testify/require"
+	"math"
+	"testing"
+)
+
+func isEven(v int) bool {
+	return v%2 == 0
+}
+
+func double(v int) int {
+	return v * 2
+}
+
+// upTo returns a single-ended Iterable over 1..n.
+func upTo(n int) iterable.Iterable[int] {
+	i := 0
+	return iterable.FromFunc(func() (int, bool) {
+		i++
+		return i, i <= n
+	})
+}
+
+func TestSlice_NextBack(t *testing.T) {
+	itr := iterable.New([]int{1, 2, 3, 4}).(iterable.DoubleEnded[int])
+	require.Equal(t, 4, itr.NextBack())
+	require.Equal(t, 1, itr.Next())
+	require.Equal(t, 3, itr.NextBack())
+	require.Equal(t, []int{2}, itr.ToSlice())
+	require.False(t, itr.HasNext())
+}
+
+func TestDoubleEnded_Preserved(t *testing.T) {
+	_, ok := iterable.Map(iterable.New([]int{1}), double).(iterable.DoubleEnded[int])
+	require.True(t, ok)
+	_, ok = iterable.New([]int{1}).Filter(isEven).(iterable.DoubleEnded[int])
+	require.True(t, ok)
+	_, ok = iterable.Map(iterable.New([]int{1}).Filter(isEven), double).(iterable.DoubleEnded[int])
+	require.True(t, ok)
+	_, ok = iterable.New([]int{1}).Reverse().(iterable.DoubleEnded[int])
+	require.True(t, ok)
+	_, ok = upTo(1).(iterable.DoubleEnded[int])
+	require.False(t, ok)
+}
+
+func TestFilter_NextBack(t *testing.T) {
+	for _, tc := range []struct {
+		input    []int
+		expected []int
+	}{
+		{[]int{}, nil},
+		{[]int{1}, nil},
+		{[]int{2}, []int{2}},
+		{[]int{1, 2, 3, 4, 5, 6}, []int{6, 4, 2}},
+		{[]int{2, 1, 1, 1}, []int{2}},
+	} {
+		t.Run(fmt.Sprintf("%v", tc.input), func(t *testing.T) {
+			itr := iterable.New(tc.input).Filter(isEven).(iterable.DoubleEnded[int])
+			var res []int
+			for itr.HasNext() {
+				res = append(res, itr.NextBack())
+			}
+			require.Equal(t, tc.expected, res)
+		})
+	}
+}
+
+func TestFilter_BothEnds(t *testing.T) {
+	itr := iterable.New([]int{1, 2, 3, 4, 5, 6}).Filter(isEven).(iterable.DoubleEnded[int])
+	require.True(t, itr.HasNext())
+	require.Equal(t, 6, itr.NextBack())
+	require.Equal(t, 2, itr.Next())
+	require.Equal(t, 4, itr.NextBack())
+	require.False(t, itr.HasNext())
+}
+
+func TestReverse(t *testing.T) {
+	testCases := []struct {
+		name     string
+		input    iterable.Iterable[int]
+		expected []int
+	}{
+		{"Empty", iterable.New([]int{}), nil},
+		{"Slice", iterable.New([]int{1, 2, 3}), []int{3, 2, 1}},
+		{"Map", iterable.Map(iterable.New([]int{1, 2, 3}), double), []int{6, 4, 2}},
+		{"Filter", iterable.New([]int{1, 2, 3, 4}).Filter(isEven), []int{4, 2}},
+		{"Reversed twice", iterable.New([]int{1, 2, 3}).Reverse().Reverse(), []int{3, 2, 1}},
+		{"Func", upTo(3), []int{3, 2, 1}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, tc.input.Reverse().ToSlice())
+		})
+	}
+}
+
+func TestLast(t *testing.T) {
+	testCases := []struct {
+		name     string
+		input    iterable.Iterable[int]
+		expected iterable.Tuple[int, bool]
+	}{
+		{"Empty", iterable.New([]int{}), iterable.Tuple[int, bool]{}},
+		{"Slice", iterable.New([]int{1, 2, 3}), iterable.Tuple[int, bool]{A: 3, B: true}},
+		{"Filter", iterable.New([]int{1, 2, 3}).Filter(isEven), iterable.Tuple[int, bool]{A: 2, B: true}},
+		{"Filter none", iterable.New([]int{1, 3}).Filter(isEven), iterable.Tuple[int, bool]{}},
+		{"Func", upTo(3), iterable.Tuple[int, bool]{A: 3, B: true}},
+		{"Func empty", upTo(0), iterable.Tuple[int, bool]{}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, ok := tc.input.Last()
+			require.Equal(t, tc.expected, iterable.Tuple[int, bool]{A: res, B: ok})
+		})
+	}
+}
+
+func TestLast_DoesNotTraverse(t *testing.T) {
+	calls := 0
+	itr := iterable.Map(iterable.New([]int{1, 2, 3}), func(v int) int {
+		calls++
+		return v
+	})
+	res, ok := itr.Last()
+	require.True(t, ok)
+	require.Equal(t, 3, res)
+	require.Equal(t, 1, calls)
+}
+
+func TestTakeLast(t *testing.T) {
+	testCases := []struct {
+		name     string
+		input    func() iterable.Iterable[int]
+		n        int
+		expected []int
+	}{
+		{"Slice", func() iterable.Iterable[int] { return iterable.New([]int{1, 2, 3, 4}) }, 2, []int{3, 4}},
+		{"Slice more", func() iterable.Iterable[int] { return iterable.New([]int{1, 2}) }, 5, []int{1, 2}},
+		{"Slice zero", func() iterable.Iterable[int] { return iterable.New([]int{1, 2}) }, 0, []int{}},
+		{"Slice negative", func() iterable.Iterable[int] { return iterable.New([]int{1, 2}) }, -1, []int{}},
+		{"Map", func() iterable.Iterable[int] { return iterable.Map(iterable.New([]int{1, 2, 3}), double) }, 2, []int{4, 6}},
+		{"Filter", func() iterable.Iterable[int] { return iterable.New([]int{1, 2, 3, 4, 6}).Filter(isEven) }, 2, []int{4, 6}},
+		{"Func", func() iterable.Iterable[int] { return upTo(5) }, 3, []int{3, 4, 5}},
+		{"Func wrap", func() iterable.Iterable[int] { return upTo(7) }, 3, []int{5, 6, 7}},
+		{"Func more", func() iterable.Iterable[int] { return upTo(2) }, 3, []int{1, 2}},
+		{"Func zero", func() iterable.Iterable[int] { return upTo(2) }, 0, nil},
+		{"Slice huge", func() iterable.Iterable[int] { return iterable.New([]int{1, 2}) }, math.MaxInt, []int{1, 2}},
+		{"Map huge", func() iterable.Iterable[int] { return iterable.Map(iterable.New([]int{1, 2}), double) }, math.MaxInt, []int{2, 4}},
+		{"Filter huge", func() iterable.Iterable[int] { return iterable.New([]int{1, 2, 3, 4}).Filter(isEven) }, math.MaxInt, []int{2, 4}},
+		{"Func huge", func() iterable.Iterable[int] { return upTo(2) }, math.MaxInt, []int{1, 2}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, tc.input().TakeLast(tc.n).ToSlice())
+		})
+	}
+}
+
+func TestSlice_TakeLast_Consumes(t *testing.T) {
+	itr := iterable.New([]int{1, 2, 3, 4})
+	require.Equal(t, 1, itr.Next())
+	require.Equal(t, []int{2, 3, 4}, itr.TakeLast(5).ToSlice())
+	require.False(t, itr.HasNext())
+}
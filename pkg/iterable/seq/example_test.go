@@ -0,0 +1,17 @@
+package seq_test
+
+import (
+	"fmt"
+	"github.com/sergeychunayev/gofu/pkg/iterable/seq"
+)
+
+func Example() {
+	squares := seq.Map(seq.Range(1, 5), func(v int) int {
+		return v * v
+	})
+	sum, _ := squares.Iter().Reduce(func(acc int, v int) int {
+		return acc + v
+	})
+	fmt.Println(squares.Iter().ToSlice(), sum)
+	// Output: [1 4 9 16] 30
+}
@@ -0,0 +1,181 @@
+package seq
+
+import (
+	"github.com/sergeychunayev/gofu/pkg/iterable"
+	"github.com/sergeychunayev/gofu/pkg/iterable/pipeline"
+)
+
+// Sequence is a re-iterable collection. Unlike an Iterable, which is a
+// one-shot cursor, a Sequence can be traversed any number of times: every
+// call to Iter returns a fresh Iterable positioned at the start. A
+// *iterable.Memo is a Sequence too.
+type Sequence[T any] interface {
+	Iter() iterable.Iterable[T]
+}
+
+// Func adapts a function returning a fresh Iterable to Sequence.
+type Func[T any] func() iterable.Iterable[T]
+
+func (f Func[T]) Iter() iterable.Iterable[T] {
+	return f()
+}
+
+// Of returns a Sequence over the elements of slice. The slice is not
+// copied, so later changes to it are seen by later iterations. This
+// includes Sort on an Iterable returned by Iter, which sorts slice in place
+// like it does for any iterable.Slice; sort a copy to keep the order of the
+// Sequence.
+func Of[T any](slice []T) Sequence[T] {
+	return Func[T](func() iterable.Iterable[T] {
+		return iterable.New(slice)
+	})
+}
+
+// Keys returns a Sequence over the keys of m in unspecified order, which
+// may differ between iterations.
+func Keys[K comparable, V any](m map[K]V) Sequence[K] {
+	return Func[K](func() iterable.Iterable[K] {
+		res := make([]K, 0, len(m))
+		for k := range m {
+			res = append(res, k)
+		}
+		return iterable.New(res)
+	})
+}
+
+// Values returns a Sequence over the values of m in unspecified order,
+// which may differ between iterations.
+func Values[K comparable, V any](m map[K]V) Sequence[V] {
+	return Func[V](func() iterable.Iterable[V] {
+		res := make([]V, 0, len(m))
+		for _, v := range m {
+			res = append(res, v)
+		}
+		return iterable.New(res)
+	})
+}
+
+// Entries returns a Sequence over the key-value pairs of m in unspecified
+// order, which may differ between iterations.
+func Entries[K comparable, V any](m map[K]V) Sequence[iterable.Tuple[K, V]] {
+	return Func[iterable.Tuple[K, V]](func() iterable.Iterable[iterable.Tuple[K, V]] {
+		res := make([]iterable.Tuple[K, V], 0, len(m))
+		for k, v := range m {
+			res = append(res, iterable.Tuple[K, V]{A: k, B: v})
+		}
+		return iterable.New(res)
+	})
+}
+
+type Integer interface {
+	~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~int | ~int8 | ~int16 | ~int32 | ~int64
+}
+
+// Range returns a Sequence over the integers from start up to but not
+// including end.
+func Range[T Integer](start T, end T) Sequence[T] {
+	return RangeStep(start, end, 1)
+}
+
+// RangeStep returns a Sequence over the integers from start up to but not
+// including end, stepping by step. A negative step counts down. Panics if
+// step is 0 or its magnitude does not fit in T.
+func RangeStep[T Integer](start T, end T, step int) Sequence[T] {
+	if step == 0 {
+		panic("seq: step must not be 0")
+	}
+	mag := uint64(step)
+	if step < 0 {
+		// -step overflows for math.MinInt
+		mag = uint64(-(step + 1)) + 1
+	}
+	delta := T(mag)
+	if delta <= 0 || uint64(delta) != mag {
+		panic("seq: step out of range")
+	}
+	return Func[T](func() iterable.Iterable[T] {
+		cur := start
+		done := false
+		return iterable.FromFunc(func() (T, bool) {
+			if done || (step > 0 && cur >= end) || (step < 0 && cur <= end) {
+				done = true
+				return cur, false
+			}
+			res := cur
+			next := cur + delta
+			if step < 0 {
+				next = cur - delta
+			}
+			// Stop rather than wrap around at the bounds of T.
+			if (step > 0 && next < cur) || (step < 0 && next > cur) {
+				done = true
+			}
+			cur = next
+			return res, true
+		})
+	})
+}
+
+// Generate returns a Sequence backed by a generator. newNext is called at
+// the start of every iteration and must return a fresh next function, as
+// accepted by iterable.FromFunc.
+func Generate[T any](newNext func() func() (T, bool)) Sequence[T] {
+	return Func[T](func() iterable.Iterable[T] {
+		return iterable.FromFunc(newNext())
+	})
+}
+
+// Filter returns a Sequence over the elements of s for which f returns
+// true.
+func Filter[T any](s Sequence[T], f func(v T) bool) Sequence[T] {
+	return Func[T](func() iterable.Iterable[T] {
+		return s.Iter().Filter(f)
+	})
+}
+
+// Map returns a Sequence over the elements of s converted with f.
+func Map[T any, U any](s Sequence[T], f func(v T) U) Sequence[U] {
+	return Func[U](func() iterable.Iterable[U] {
+		return iterable.Map(s.Iter(), f)
+	})
+}
+
+// Apply returns a Sequence over the result of running p on the elements of
+// s.
+func Apply[T any, U any](s Sequence[T], p pipeline.Pipeline[T, U]) Sequence[U] {
+	return Func[U](func() iterable.Iterable[U] {
+		return pipeline.Apply(p, s.Iter())
+	})
+}
+
+// Concat returns a Sequence over the elements of every one of seqs in turn.
+func Concat[T any](seqs ...Sequence[T]) Sequence[T] {
+	return Func[T](func() iterable.Iterable[T] {
+		i := 0
+		var cur iterable.Iterable[T]
+		return iterable.FromFunc(func() (T, bool) {
+			for {
+				if cur != nil {
+					if v, ok := cur.TryNext(); ok {
+						return v, true
+					}
+				}
+				if i == len(seqs) {
+					var zero T
+					return zero, false
+				}
+				cur = seqs[i].Iter()
+				i++
+			}
+		})
+	})
+}
+
+// Zip returns a Sequence of pairs of the elements of a and b, as long as
+// the shorter of them.
+func Zip[A any, B any](a Sequence[A], b Sequence[B]) Sequence[iterable.Tuple[A, B]] {
+	return Func[iterable.Tuple[A, B]](func() iterable.Iterable[iterable.Tuple[A, B]] {
+		return iterable.Zip(a.Iter(), b.Iter())
+	})
+}
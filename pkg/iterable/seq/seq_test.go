@@ -0,0 +1,131 @@
+package seq
+
+import (
+	"github.com/sergeychunayev/gofu/pkg/iterable"
+	"github.com/sergeychunayev/gofu/pkg/iterable/pipeline"
+	"github.com/stretchr/testify/require"
+	"math"
+	"strconv"
+	"testing"
+)
+
+func isEven(v int) bool {
+	return v%2 == 0
+}
+
+// twice collects s twice to check that it can be traversed again.
+func twice[T any](t *testing.T, s Sequence[T]) []T {
+	res := s.Iter().ToSlice()
+	require.Equal(t, res, s.Iter().ToSlice())
+	return res
+}
+
+func TestOf(t *testing.T) {
+	require.Equal(t, []int{1, 2, 3}, twice(t, Of([]int{1, 2, 3})))
+	require.Empty(t, twice(t, Of[int](nil)))
+}
+
+func TestOf_Sort(t *testing.T) {
+	// Sort works in place on the shared slice, so later iterations see the
+	// new order
+	s := Of([]int{3, 1, 2})
+	require.Equal(t, []int{1, 2, 3}, s.Iter().Sort(func(a int, b int) bool {
+		return a < b
+	}).ToSlice())
+	require.Equal(t, []int{1, 2, 3}, s.Iter().ToSlice())
+}
+
+func TestMaps(t *testing.T) {
+	m := map[string]int{"a": 1, "b": 2}
+	require.ElementsMatch(t, []string{"a", "b"}, Keys(m).Iter().ToSlice())
+	require.ElementsMatch(t, []string{"a", "b"}, Keys(m).Iter().ToSlice())
+	require.ElementsMatch(t, []int{1, 2}, Values(m).Iter().ToSlice())
+	require.ElementsMatch(t, []iterable.Tuple[string, int]{{A: "a", B: 1}, {A: "b", B: 2}}, Entries(m).Iter().ToSlice())
+}
+
+func TestRange(t *testing.T) {
+	testCases := []struct {
+		name     string
+		s        Sequence[int]
+		expected []int
+	}{
+		{"Range", Range(0, 4), []int{0, 1, 2, 3}},
+		{"Empty", Range(4, 4), nil},
+		{"Backwards", Range(4, 0), nil},
+		{"Step", RangeStep(0, 7, 3), []int{0, 3, 6}},
+		{"Negative step", RangeStep(5, 0, -2), []int{5, 3, 1}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, twice(t, tc.s))
+		})
+	}
+	require.Panics(t, func() {
+		RangeStep(0, 1, 0)
+	})
+}
+
+func TestRange_Bounds(t *testing.T) {
+	require.Equal(t, []uint8{250, 253}, twice(t, RangeStep[uint8](250, math.MaxUint8, 3)))
+	require.Equal(t, []uint8{2, 1}, twice(t, RangeStep[uint8](2, 0, -1)))
+	require.Equal(t, []int8{120, 125}, twice(t, RangeStep[int8](120, math.MaxInt8, 5)))
+	require.Equal(t, []int8{-120, -125}, twice(t, RangeStep[int8](-120, math.MinInt8, -5)))
+	require.Equal(t, []uint8{0, 200}, twice(t, RangeStep[uint8](0, math.MaxUint8, 200)))
+	require.Equal(t, []uint64{math.MaxUint64, math.MaxInt64}, twice(t, RangeStep[uint64](math.MaxUint64, 0, math.MinInt64)))
+}
+
+func TestRangeStep_OutOfRange(t *testing.T) {
+	require.Panics(t, func() {
+		RangeStep[uint8](0, 200, 300)
+	})
+	require.Panics(t, func() {
+		RangeStep[uint8](200, 0, -256)
+	})
+	require.Panics(t, func() {
+		RangeStep[int8](0, 100, 128)
+	})
+	require.Panics(t, func() {
+		RangeStep[int8](0, -100, -129)
+	})
+	require.Panics(t, func() {
+		RangeStep[int64](0, -1, math.MinInt64)
+	})
+}
+
+func TestGenerate(t *testing.T) {
+	s := Generate(func() func() (int, bool) {
+		a, b := 0, 1
+		return func() (int, bool) {
+			res := a
+			a, b = b, a+b
+			return res, res < 10
+		}
+	})
+	require.Equal(t, []int{0, 1, 1, 2, 3, 5, 8}, twice(t, s))
+}
+
+func TestFilterMap(t *testing.T) {
+	s := Map(Filter(Range(0, 6), isEven), strconv.Itoa)
+	require.Equal(t, []string{"0", "2", "4"}, twice(t, s))
+}
+
+func TestApply(t *testing.T) {
+	s := Apply(Of([]int{1, 2, 1, 3}), pipeline.Distinct(pipeline.New[int]()).Take(2))
+	require.Equal(t, []int{1, 2}, twice(t, s))
+}
+
+func TestConcat(t *testing.T) {
+	s := Concat(Range(0, 2), Of[int](nil), Range(5, 7))
+	require.Equal(t, []int{0, 1, 5, 6}, twice(t, s))
+	require.Empty(t, twice(t, Concat[int]()))
+}
+
+func TestZip(t *testing.T) {
+	s := Zip(Range(0, 3), Of([]string{"a", "b"}))
+	require.Equal(t, []iterable.Tuple[int, string]{{A: 0, B: "a"}, {A: 1, B: "b"}}, twice(t, s))
+}
+
+func TestMemo(t *testing.T) {
+	var s Sequence[int] = iterable.Memoize(iterable.New([]int{1, 2}))
+	require.Equal(t, []int{1, 2}, twice(t, s))
+}
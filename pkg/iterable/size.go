@@ -0,0 +1,65 @@
+package iterable
+
+// SizeHinter is implemented by iterables that can tell how many elements
+// are left. SizeHint returns an upper bound, or -1 if it is unknown, and
+// whether the bound is exact.
+type SizeHinter interface {
+	SizeHint() (int, bool)
+}
+
+// Len returns the number of elements left in it if it is known without
+// consuming it.
+func Len[T any](it Iterable[T]) (int, bool) {
+	n, exact := sizeHint(it)
+	return n, exact && n >= 0
+}
+
+func sizeHint[T any](it Iterable[T]) (int, bool) {
+	if s, ok := it.(SizeHinter); ok {
+		return s.SizeHint()
+	}
+	return -1, false
+}
+
+func (v *Slice[T]) SizeHint() (int, bool) {
+	return len(v.slice) - v.i, true
+}
+
+func (v *mapIterable[T, U]) SizeHint() (int, bool) {
+	return sizeHint(v.itr)
+}
+
+func (v *filterIterable[T]) SizeHint() (int, bool) {
+	n, exact := sizeHint(v.itr)
+	if n < 0 {
+		return n, false
+	}
+	// the remaining elements of the source may all be filtered out
+	exact = exact && n == 0
+	if v.cur != nil {
+		n++
+	}
+	return n, exact
+}
+
+func (v *reverseIterable[T]) SizeHint() (int, bool) {
+	return sizeHint[T](v.itr)
+}
+
+func (v *cycleIterable[T]) SizeHint() (int, bool) {
+	if v.src == nil && (len(v.slice) == 0 || v.passes == 0) {
+		return 0, true
+	}
+	if v.passes < 0 {
+		return -1, false
+	}
+	if v.src == nil {
+		return len(v.slice) - v.i + (v.passes-1)*len(v.slice), true
+	}
+	// the rest of the first pass, then passes-1 whole passes
+	n, exact := sizeHint(v.src)
+	if n < 0 {
+		return n, false
+	}
+	return n + (v.passes-1)*(len(v.slice)+n), exact
+}
@@ -0,0 +1,115 @@
+package iterable_test
+
+import (
+	"github.com/sergeychunayev/gofu/pkg/iterable"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestLen(t *testing.T) {
+	testCases := []struct {
+		name     string
+		input    iterable.Iterable[int]
+		expected iterable.Tuple[int, bool]
+	}{
+		{"Empty", iterable.New([]int{}), iterable.Tuple[int, bool]{A: 0, B: true}},
+		{"Slice", iterable.New([]int{1, 2, 3}), iterable.Tuple[int, bool]{A: 3, B: true}},
+		{"Map", iterable.Map(iterable.New([]int{1, 2, 3}), double), iterable.Tuple[int, bool]{A: 3, B: true}},
+		{"Reverse", iterable.New([]int{1, 2, 3}).Reverse(), iterable.Tuple[int, bool]{A: 3, B: true}},
+		{"Filter", iterable.New([]int{1, 2, 3}).Filter(isEven), iterable.Tuple[int, bool]{}},
+		{"Filter empty", iterable.New([]int{}).Filter(isEven), iterable.Tuple[int, bool]{A: 0, B: true}},
+		{"Func", upTo(3), iterable.Tuple[int, bool]{A: -1}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			n, ok := iterable.Len(tc.input)
+			if !tc.expected.B {
+				require.False(t, ok)
+				return
+			}
+			require.True(t, ok)
+			require.Equal(t, tc.expected.A, n)
+		})
+	}
+}
+
+func TestSizeHint(t *testing.T) {
+	itr := iterable.New([]int{1, 2, 3, 4})
+	itr.Next()
+	n, exact := itr.(iterable.SizeHinter).SizeHint()
+	require.Equal(t, 3, n)
+	require.True(t, exact)
+
+	filter := itr.Filter(isEven)
+	n, exact = filter.(iterable.SizeHinter).SizeHint()
+	require.Equal(t, 3, n)
+	require.False(t, exact)
+
+	require.True(t, filter.HasNext())
+	require.Equal(t, 2, filter.Next())
+	n, exact = filter.(iterable.SizeHinter).SizeHint()
+	require.Equal(t, 2, n)
+	require.False(t, exact)
+
+	require.True(t, filter.HasNext())
+	n, exact = filter.(iterable.SizeHinter).SizeHint()
+	require.Equal(t, 1, n)
+	require.True(t, exact)
+}
+
+func TestCount(t *testing.T) {
+	testCases := []struct {
+		name     string
+		input    iterable.Iterable[int]
+		expected int
+	}{
+		{"Empty", iterable.New([]int{}), 0},
+		{"Slice", iterable.New([]int{1, 2, 3}), 3},
+		{"Map", iterable.Map(iterable.New([]int{1, 2, 3}), double), 3},
+		{"Filter", iterable.New([]int{1, 2, 3, 4}).Filter(isEven), 2},
+		{"Func", upTo(5), 5},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, tc.input.Count())
+			require.False(t, tc.input.HasNext())
+		})
+	}
+}
+
+func TestToSlice_Preallocated(t *testing.T) {
+	res := iterable.Map(iterable.New([]int{1, 2, 3}), double).ToSlice()
+	require.Equal(t, []int{2, 4, 6}, res)
+	require.Equal(t, 3, cap(res))
+}
+
+func TestToSlice_UpperBound(t *testing.T) {
+	arr := make([]int, 1000)
+	arr[500] = 1
+	res := iterable.New(arr).Filter(func(v int) bool {
+		return v == 1
+	}).ToSlice()
+	require.Equal(t, []int{1}, res)
+	require.Equal(t, 1, cap(res))
+
+	res = iterable.CycleN(iterable.New([]int{1, 2, 3, 4}).Filter(func(v int) bool {
+		return v == 1
+	}), 2).ToSlice()
+	require.Equal(t, []int{1, 1}, res)
+	require.LessOrEqual(t, cap(res), 2)
+}
+
+func BenchmarkMap_ToSlice(b *testing.B) {
+	arr := make([]int, 10000)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		iterable.Map(iterable.New(arr), double).ToSlice()
+	}
+}
+
+func BenchmarkFunc_ToSlice(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		upTo(10000).ToSlice()
+	}
+}
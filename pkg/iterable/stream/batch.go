@@ -0,0 +1,64 @@
+package stream
+
+import (
+	"github.com/sergeychunayev/gofu/pkg/clock"
+	"github.com/sergeychunayev/gofu/pkg/iterable"
+	"time"
+)
+
+// BatchByTime groups the elements of it into batches of at most maxSize
+// elements, emitting a smaller batch once maxWait has elapsed since its first
+// element arrived. Once the context is done the batch in progress, if any,
+// is emitted and the iteration ends. Unless it is a Slice, it is read by a
+// goroutine; cancel the context if the result is abandoned early.
+func BatchByTime[T any](it iterable.Iterable[T], maxSize int, maxWait time.Duration, opts ...Option) iterable.Iterable[[]T] {
+	if maxSize <= 0 {
+		panic("stream: non-positive batch size")
+	}
+	cfg := newConfig(opts)
+	var in <-chan T
+	next := func() ([]T, bool) {
+		if in == nil {
+			in = source(cfg, it)
+		}
+		var batch []T
+		var timer clock.Timer
+		var timeout <-chan time.Time
+		for {
+			// a Slice source is always ready, so check the context first to
+			// stop deterministically once it is done
+			if cfg.ctx.Err() != nil {
+				stop(timer)
+				return batch, len(batch) > 0
+			}
+			select {
+			case v, ok := <-in:
+				if !ok {
+					stop(timer)
+					return batch, len(batch) > 0
+				}
+				if batch == nil {
+					timer = cfg.clock.NewTimer(maxWait)
+					timeout = timer.C()
+				}
+				batch = append(batch, v)
+				if len(batch) == maxSize {
+					stop(timer)
+					return batch, true
+				}
+			case <-timeout:
+				return batch, true
+			case <-cfg.ctx.Done():
+				stop(timer)
+				return batch, len(batch) > 0
+			}
+		}
+	}
+	return iterable.FromFunc(next)
+}
+
+func stop(t clock.Timer) {
+	if t != nil {
+		t.Stop()
+	}
+}
@@ -0,0 +1,23 @@
+package stream_test
+
+import (
+	"fmt"
+	"github.com/sergeychunayev/gofu/pkg/iterable"
+	"github.com/sergeychunayev/gofu/pkg/iterable/stream"
+	"time"
+)
+
+func ExampleBatchByTime() {
+	batches := stream.BatchByTime(iterable.New([]int{1, 2, 3, 4, 5}), 2, 200*time.Millisecond)
+	fmt.Println(batches.ToSlice())
+	// Output: [[1 2] [3 4] [5]]
+}
+
+func ExampleThrottle() {
+	itr := stream.Throttle(iterable.New([]string{"a", "b", "c"}), 1000, 3)
+	res := iterable.Map(itr, func(v string) string {
+		return "called " + v
+	}).ToSlice()
+	fmt.Println(res)
+	// Output: [called a called b called c]
+}
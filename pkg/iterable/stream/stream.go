@@ -0,0 +1,85 @@
+package stream
+
+import (
+	"context"
+	"github.com/sergeychunayev/gofu/pkg/clock"
+	"github.com/sergeychunayev/gofu/pkg/iterable"
+	"time"
+)
+
+// Option configures a streaming stage.
+type Option func(c *config)
+
+type config struct {
+	ctx   context.Context
+	clock clock.Clock
+}
+
+// WithContext makes the stage stop once ctx is done.
+func WithContext(ctx context.Context) Option {
+	return func(c *config) {
+		c.ctx = ctx
+	}
+}
+
+// WithClock makes the stage measure time with clk instead of the real clock.
+func WithClock(clk clock.Clock) Option {
+	return func(c *config) {
+		c.clock = clk
+	}
+}
+
+func newConfig(opts []Option) *config {
+	res := &config{context.Background(), clock.Real()}
+	for _, o := range opts {
+		o(res)
+	}
+	return res
+}
+
+// sleep waits for d and reports false if the context is done first.
+func (c *config) sleep(d time.Duration) bool {
+	if d <= 0 {
+		return c.ctx.Err() == nil
+	}
+	timer := c.clock.NewTimer(d)
+	select {
+	case <-timer.C():
+		return true
+	case <-c.ctx.Done():
+		timer.Stop()
+		return false
+	}
+}
+
+// source returns a channel with the elements of it. Slices are already in
+// memory, so they are copied into a closed, buffered channel instead of
+// being sent by a goroutine. Any other Iterable is read by a goroutine that
+// lives until it is exhausted or the context is done.
+func source[T any](cfg *config, it iterable.Iterable[T]) <-chan T {
+	if s, ok := it.(*iterable.Slice[T]); ok {
+		n, _ := iterable.Len[T](s)
+		res := make(chan T, n)
+		for v, ok := s.TryNext(); ok; v, ok = s.TryNext() {
+			res <- v
+		}
+		close(res)
+		return res
+	}
+	return iterable.ToChan(cfg.ctx, it)
+}
+
+// receive returns the next element of in, or false once in is closed or the
+// context is done. A done context takes priority over ready elements.
+func receive[T any](cfg *config, in <-chan T) (T, bool) {
+	var zero T
+	if cfg.ctx.Err() != nil {
+		return zero, false
+	}
+	select {
+	case v, ok := <-in:
+		return v, ok
+	case <-cfg.ctx.Done():
+		return zero, false
+	}
+}
@@ -0,0 +1,190 @@
+package stream_test
+
+import (
+	"context"
+	"github.com/sergeychunayev/gofu/pkg/clock"
+	"github.com/sergeychunayev/gofu/pkg/iterable"
+	"github.com/sergeychunayev/gofu/pkg/iterable/stream"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+var start = time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// consume drains it in the background and returns the channel of its
+// elements.
+func consume[T any](it iterable.Iterable[T]) <-chan T {
+	return iterable.ToChan(context.Background(), it)
+}
+
+func TestBatchByTime(t *testing.T) {
+	clk := clock.NewFake(start)
+	ch := make(chan int)
+	out := consume(stream.BatchByTime(iterable.FromChan(ch), 3, 100*time.Millisecond, stream.WithClock(clk)))
+
+	ch <- 1
+	ch <- 2
+	ch <- 3
+	require.Equal(t, []int{1, 2, 3}, <-out)
+
+	ch <- 4
+	clk.BlockUntil(1)
+	clk.Advance(99 * time.Millisecond)
+	ch <- 5
+	clk.Advance(time.Millisecond)
+	require.Equal(t, []int{4, 5}, <-out)
+
+	ch <- 6
+	close(ch)
+	require.Equal(t, []int{6}, <-out)
+	_, ok := <-out
+	require.False(t, ok)
+}
+
+func TestBatchByTime_Slice(t *testing.T) {
+	res := stream.BatchByTime(iterable.New([]int{1, 2, 3, 4, 5}), 2, time.Hour).ToSlice()
+	require.Equal(t, [][]int{{1, 2}, {3, 4}, {5}}, res)
+}
+
+func TestBatchByTime_Empty(t *testing.T) {
+	res := stream.BatchByTime(iterable.New([]int{}), 2, time.Hour).ToSlice()
+	require.Empty(t, res)
+}
+
+func TestBatchByTime_Cancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	clk := clock.NewFake(start)
+	ch := make(chan int)
+	out := consume(stream.BatchByTime(iterable.FromChan(ch), 3, time.Hour, stream.WithContext(ctx), stream.WithClock(clk)))
+	ch <- 1
+	clk.BlockUntil(1)
+	cancel()
+	require.Equal(t, []int{1}, <-out)
+	_, ok := <-out
+	require.False(t, ok)
+}
+
+func TestBatchByTime_SliceCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	// the elements of a Slice are always ready, so repeat to catch a
+	// receive racing with the done context
+	for i := 0; i < 100; i++ {
+		res := stream.BatchByTime(iterable.New([]int{1, 2, 3, 4, 5, 6, 7, 8}), 2, time.Hour, stream.WithContext(ctx)).ToSlice()
+		require.Empty(t, res)
+	}
+}
+
+func TestBatchByTime_InvalidSize(t *testing.T) {
+	require.Panics(t, func() {
+		stream.BatchByTime(iterable.New([]int{}), 0, time.Hour)
+	})
+}
+
+func TestTumblingWindow(t *testing.T) {
+	clk := clock.NewFake(start)
+	ch := make(chan int)
+	out := consume(stream.TumblingWindow(iterable.FromChan(ch), 10*time.Second, stream.WithClock(clk)))
+	clk.BlockUntil(1)
+
+	ch <- 1
+	ch <- 2
+	clk.Advance(10 * time.Second)
+	require.Equal(t, stream.Window[int]{
+		Start: start,
+		End:   start.Add(10 * time.Second),
+		Items: []int{1, 2},
+	}, <-out)
+
+	clk.BlockUntil(1)
+	clk.Advance(10 * time.Second)
+	clk.BlockUntil(1)
+	ch <- 3
+	clk.Advance(10 * time.Second)
+	require.Equal(t, stream.Window[int]{
+		Start: start.Add(20 * time.Second),
+		End:   start.Add(30 * time.Second),
+		Items: []int{3},
+	}, <-out)
+
+	clk.BlockUntil(1)
+	ch <- 4
+	close(ch)
+	require.Equal(t, stream.Window[int]{
+		Start: start.Add(30 * time.Second),
+		End:   start.Add(40 * time.Second),
+		Items: []int{4},
+	}, <-out)
+	_, ok := <-out
+	require.False(t, ok)
+}
+
+func TestSlidingWindow(t *testing.T) {
+	clk := clock.NewFake(start)
+	ch := make(chan int)
+	out := consume(stream.SlidingWindow(iterable.FromChan(ch), 10*time.Second, 5*time.Second, stream.WithClock(clk)))
+	clk.BlockUntil(1)
+
+	ch <- 1
+	clk.Advance(5 * time.Second)
+	clk.BlockUntil(1)
+	ch <- 2
+	clk.Advance(5 * time.Second)
+	require.Equal(t, stream.Window[int]{
+		Start: start,
+		End:   start.Add(10 * time.Second),
+		Items: []int{1, 2},
+	}, <-out)
+
+	clk.BlockUntil(1)
+	ch <- 3
+	clk.Advance(5 * time.Second)
+	require.Equal(t, stream.Window[int]{
+		Start: start.Add(5 * time.Second),
+		End:   start.Add(15 * time.Second),
+		Items: []int{2, 3},
+	}, <-out)
+
+	clk.BlockUntil(1)
+	close(ch)
+	require.Equal(t, stream.Window[int]{
+		Start: start.Add(10 * time.Second),
+		End:   start.Add(20 * time.Second),
+		Items: []int{3},
+	}, <-out)
+	_, ok := <-out
+	require.False(t, ok)
+}
+
+func TestTumblingWindow_Slice(t *testing.T) {
+	res := stream.TumblingWindow(iterable.New([]int{1, 2, 3}), time.Hour).ToSlice()
+	require.Len(t, res, 1)
+	require.Equal(t, []int{1, 2, 3}, res[0].Items)
+}
+
+func TestSlidingWindow_Slice(t *testing.T) {
+	clk := clock.NewFake(start)
+	res := stream.SlidingWindow(iterable.New([]int{1, 2, 3}), 10*time.Second, 5*time.Second, stream.WithClock(clk)).ToSlice()
+	// every element arrives at start, so later overlapping windows are empty
+	require.Equal(t, []stream.Window[int]{{
+		Start: start,
+		End:   start.Add(10 * time.Second),
+		Items: []int{1, 2, 3},
+	}}, res)
+}
+
+func TestTumblingWindow_SliceCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	for i := 0; i < 100; i++ {
+		res := stream.TumblingWindow(iterable.New([]int{1, 2, 3}), time.Hour, stream.WithContext(ctx)).ToSlice()
+		require.Empty(t, res)
+	}
+}
+
+func TestSlidingWindow_Invalid(t *testing.T) {
+	require.Panics(t, func() {
+		stream.SlidingWindow(iterable.New([]int{}), time.Second, 0)
+	})
+}
@@ -0,0 +1,95 @@
+package stream
+
+import (
+	"github.com/sergeychunayev/gofu/pkg/iterable"
+	"math"
+	"time"
+)
+
+// Throttle limits the rate at which elements of it are emitted using a token
+// bucket that holds up to burst tokens and is refilled at rate tokens per
+// second. Every element takes one token, waiting for it if necessary.
+//
+// Unless it is a Slice, it is read by a goroutine, so that a done context
+// also interrupts waiting for the next element; cancel the context if the
+// result is abandoned early. The same holds for Delay and Spacing.
+func Throttle[T any](it iterable.Iterable[T], rate float64, burst int, opts ...Option) iterable.Iterable[T] {
+	if rate <= 0 || burst <= 0 {
+		panic("stream: non-positive rate or burst")
+	}
+	cfg := newConfig(opts)
+	var in <-chan T
+	tokens := float64(burst)
+	var last time.Time
+	next := func() (T, bool) {
+		if in == nil {
+			in = source(cfg, it)
+		}
+		res, ok := receive(cfg, in)
+		if !ok {
+			return res, false
+		}
+
+		now := cfg.clock.Now()
+		if !last.IsZero() {
+			tokens = math.Min(float64(burst), tokens+now.Sub(last).Seconds()*rate)
+		}
+		last = now
+		var d time.Duration
+		if tokens < 1 {
+			d = time.Duration(math.Ceil((1 - tokens) / rate * float64(time.Second)))
+			last = last.Add(d)
+			tokens = 1
+		}
+		if !cfg.sleep(d) {
+			return res, false
+		}
+		tokens--
+		return res, true
+	}
+	return iterable.FromFunc(next)
+}
+
+// Delay postpones every element of it by d.
+func Delay[T any](it iterable.Iterable[T], d time.Duration, opts ...Option) iterable.Iterable[T] {
+	cfg := newConfig(opts)
+	var in <-chan T
+	next := func() (T, bool) {
+		if in == nil {
+			in = source(cfg, it)
+		}
+		res, ok := receive(cfg, in)
+		if !ok {
+			return res, false
+		}
+		return res, cfg.sleep(d)
+	}
+	return iterable.FromFunc(next)
+}
+
+// Spacing emits the elements of it at least d apart. The first element is
+// emitted immediately.
+func Spacing[T any](it iterable.Iterable[T], d time.Duration, opts ...Option) iterable.Iterable[T] {
+	cfg := newConfig(opts)
+	var in <-chan T
+	var last time.Time
+	next := func() (T, bool) {
+		if in == nil {
+			in = source(cfg, it)
+		}
+		res, ok := receive(cfg, in)
+		if !ok {
+			return res, false
+		}
+		var wait time.Duration
+		if !last.IsZero() {
+			wait = last.Add(d).Sub(cfg.clock.Now())
+		}
+		if !cfg.sleep(wait) {
+			return res, false
+		}
+		last = cfg.clock.Now()
+		return res, true
+	}
+	return iterable.FromFunc(next)
+}
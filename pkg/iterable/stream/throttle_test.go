@@ -0,0 +1,135 @@
+package stream_test
+
+import (
+	"context"
+	"github.com/sergeychunayev/gofu/pkg/clock"
+	"github.com/sergeychunayev/gofu/pkg/iterable"
+	"github.com/sergeychunayev/gofu/pkg/iterable/stream"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func requireBlocked[T any](t *testing.T, out <-chan T) {
+	select {
+	case v := <-out:
+		require.Failf(t, "unexpected element", "%v", v)
+	default:
+	}
+}
+
+func TestThrottle(t *testing.T) {
+	clk := clock.NewFake(start)
+	out := consume(stream.Throttle(iterable.New([]int{1, 2, 3, 4, 5}), 10, 2, stream.WithClock(clk)))
+
+	require.Equal(t, 1, <-out)
+	require.Equal(t, 2, <-out)
+	clk.BlockUntil(1)
+	requireBlocked(t, out)
+
+	clk.Advance(100 * time.Millisecond)
+	require.Equal(t, 3, <-out)
+	clk.BlockUntil(1)
+	requireBlocked(t, out)
+
+	clk.Advance(250 * time.Millisecond)
+	require.Equal(t, 4, <-out)
+	require.Equal(t, 5, <-out)
+	_, ok := <-out
+	require.False(t, ok)
+}
+
+func TestThrottle_Cancel(t *testing.T) {
+	clk := clock.NewFake(start)
+	ctx, cancel := context.WithCancel(context.Background())
+	out := consume(stream.Throttle(iterable.New([]int{1, 2, 3}), 1, 1, stream.WithClock(clk), stream.WithContext(ctx)))
+	require.Equal(t, 1, <-out)
+	clk.BlockUntil(1)
+	cancel()
+	_, ok := <-out
+	require.False(t, ok)
+}
+
+func TestThrottle_Invalid(t *testing.T) {
+	require.Panics(t, func() {
+		stream.Throttle(iterable.New([]int{}), 0, 1)
+	})
+	require.Panics(t, func() {
+		stream.Throttle(iterable.New([]int{}), 1, 0)
+	})
+}
+
+func TestDelay(t *testing.T) {
+	clk := clock.NewFake(start)
+	out := consume(stream.Delay(iterable.New([]int{1, 2}), time.Second, stream.WithClock(clk)))
+	for _, expected := range []int{1, 2} {
+		clk.BlockUntil(1)
+		requireBlocked(t, out)
+		clk.Advance(time.Second)
+		require.Equal(t, expected, <-out)
+	}
+	_, ok := <-out
+	require.False(t, ok)
+}
+
+func TestSpacing(t *testing.T) {
+	clk := clock.NewFake(start)
+	out := consume(stream.Spacing(iterable.New([]int{1, 2, 3}), time.Second, stream.WithClock(clk)))
+	require.Equal(t, 1, <-out)
+
+	clk.BlockUntil(1)
+	clk.Advance(time.Second)
+	require.Equal(t, 2, <-out)
+
+	clk.BlockUntil(1)
+	clk.Advance(400 * time.Millisecond)
+	clk.BlockUntil(1)
+	requireBlocked(t, out)
+	clk.Advance(600 * time.Millisecond)
+	require.Equal(t, 3, <-out)
+	_, ok := <-out
+	require.False(t, ok)
+}
+
+func TestSpacing_Cancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	res := stream.Spacing(iterable.New([]int{1, 2}), time.Hour, stream.WithContext(ctx)).ToSlice()
+	require.Empty(t, res)
+}
+
+func TestThrottle_Cancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	res := stream.Throttle(iterable.New([]int{1, 2}), 1, 1, stream.WithContext(ctx)).ToSlice()
+	require.Empty(t, res)
+}
+
+func TestBlockedSource_Cancel(t *testing.T) {
+	testCases := []struct {
+		name  string
+		stage func(it iterable.Iterable[int], opt stream.Option) iterable.Iterable[int]
+	}{
+		{"Throttle", func(it iterable.Iterable[int], opt stream.Option) iterable.Iterable[int] {
+			return stream.Throttle(it, 1, 1, opt)
+		}},
+		{"Delay", func(it iterable.Iterable[int], opt stream.Option) iterable.Iterable[int] {
+			return stream.Delay(it, time.Second, opt)
+		}},
+		{"Spacing", func(it iterable.Iterable[int], opt stream.Option) iterable.Iterable[int] {
+			return stream.Spacing(it, time.Second, opt)
+		}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			// nothing is ever sent, so the stage waits for the source
+			ch := make(chan int)
+			out := consume(tc.stage(iterable.FromChan(ch), stream.WithContext(ctx)))
+			requireBlocked(t, out)
+			cancel()
+			_, ok := <-out
+			require.False(t, ok)
+		})
+	}
+}
@@ -0,0 +1,125 @@
+package stream
+
+import (
+	"github.com/sergeychunayev/gofu/pkg/clock"
+	"github.com/sergeychunayev/gofu/pkg/iterable"
+	"time"
+)
+
+// Window is a group of elements that arrived within [Start, End).
+type Window[T any] struct {
+	Start time.Time
+	End   time.Time
+	Items []T
+}
+
+type stamped[T any] struct {
+	at time.Time
+	v  T
+}
+
+// TumblingWindow groups the elements of it into consecutive, non-overlapping
+// windows of the given size. Empty windows are skipped.
+func TumblingWindow[T any](it iterable.Iterable[T], size time.Duration, opts ...Option) iterable.Iterable[Window[T]] {
+	return SlidingWindow(it, size, size, opts...)
+}
+
+// SlidingWindow groups the elements of it into windows of the given size
+// starting every slide, so an element may belong to several windows. Empty
+// windows are skipped. Once it is exhausted the remaining windows are emitted
+// without waiting. Unless it is a Slice, it is read by a goroutine; cancel
+// the context if the result is abandoned early.
+func SlidingWindow[T any](it iterable.Iterable[T], size time.Duration, slide time.Duration, opts ...Option) iterable.Iterable[Window[T]] {
+	if size <= 0 || slide <= 0 {
+		panic("stream: non-positive window size or slide")
+	}
+	cfg := newConfig(opts)
+	var in <-chan T
+	var buf []stamped[T]
+	var timer clock.Timer
+	closed := false
+
+	// Elements are stamped with the last window boundary seen rather than
+	// the exact time they arrive at. No window starts or ends between two
+	// boundaries, so this yields the same windows while keeping arrival
+	// order and timer order consistent.
+	var now, nextStart, nextEnd time.Time
+
+	emit := func() Window[T] {
+		res := Window[T]{Start: nextEnd.Add(-size), End: nextEnd}
+		for _, s := range buf {
+			if !s.at.Before(res.Start) && s.at.Before(res.End) {
+				res.Items = append(res.Items, s.v)
+			}
+		}
+		nextEnd = nextEnd.Add(slide)
+		start := nextEnd.Add(-size)
+		i := 0
+		for i < len(buf) && buf[i].at.Before(start) {
+			i++
+		}
+		buf = buf[i:]
+		return res
+	}
+
+	// boundary returns the time of the next window start or end.
+	boundary := func() time.Time {
+		if nextStart.Before(nextEnd) {
+			return nextStart
+		}
+		return nextEnd
+	}
+
+	next := func() (Window[T], bool) {
+		if in == nil {
+			in = source(cfg, it)
+			now = cfg.clock.Now()
+			nextStart = now.Add(slide)
+			nextEnd = now.Add(size)
+			timer = cfg.clock.NewTimer(boundary().Sub(now))
+		}
+		for {
+			// a Slice source is always ready, so check the context first to
+			// stop deterministically once it is done
+			if cfg.ctx.Err() != nil {
+				timer.Stop()
+				return Window[T]{}, false
+			}
+			if closed {
+				if len(buf) == 0 {
+					return Window[T]{}, false
+				}
+				if w := emit(); len(w.Items) > 0 {
+					return w, true
+				}
+				continue
+			}
+			select {
+			case v, ok := <-in:
+				if !ok {
+					closed = true
+					timer.Stop()
+					continue
+				}
+				buf = append(buf, stamped[T]{now, v})
+			case <-timer.C():
+				now = boundary()
+				if !nextStart.After(now) {
+					nextStart = nextStart.Add(slide)
+				}
+				var w Window[T]
+				if !nextEnd.After(now) {
+					w = emit()
+				}
+				timer = cfg.clock.NewTimer(boundary().Sub(cfg.clock.Now()))
+				if len(w.Items) > 0 {
+					return w, true
+				}
+			case <-cfg.ctx.Done():
+				timer.Stop()
+				return Window[T]{}, false
+			}
+		}
+	}
+	return iterable.FromFunc(next)
+}
@@ -0,0 +1,129 @@
+package iterable
+
+import "sync"
+
+type tee[T any] struct {
+	mu  sync.Mutex
+	src Iterable[T]
+	buf []T
+	// base is the position in the source of buf[0]
+	base int
+	pos  []int
+}
+
+func (t *tee[T]) next(i int) (T, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	var res T
+	off := t.pos[i] - t.base
+	if off == len(t.buf) {
+		v, ok := t.src.TryNext()
+		if !ok {
+			return res, false
+		}
+		t.buf = append(t.buf, v)
+	}
+	res = t.buf[off]
+	t.pos[i]++
+	t.trim()
+	return res, true
+}
+
+// trim drops the elements every consumer has already seen.
+func (t *tee[T]) trim() {
+	low := t.pos[0]
+	for _, p := range t.pos[1:] {
+		if p < low {
+			low = p
+		}
+	}
+	n := low - t.base
+	if n == 0 {
+		return
+	}
+	var zero T
+	for i := 0; i < n; i++ {
+		t.buf[i] = zero
+	}
+	t.buf = t.buf[n:]
+	t.base = low
+}
+
+// Tee returns n independent Iterables that yield the elements of it. Only
+// the elements between the slowest and the fastest consumer are buffered.
+// it must not be used afterwards.
+func Tee[T any](it Iterable[T], n int) []Iterable[T] {
+	t := &tee[T]{src: it, pos: make([]int, n)}
+	res := make([]Iterable[T], n)
+	for i := range res {
+		i := i
+		res[i] = FromFunc(func() (T, bool) {
+			return t.next(i)
+		})
+	}
+	return res
+}
+
+// Broadcast runs every f concurrently over the elements of it in a single
+// pass and returns their results in order. Elements are handed over one at
+// a time, so nothing is buffered; a consumer that returns early simply stops
+// receiving.
+func Broadcast[T any, R any](it Iterable[T], fs ...func(it Iterable[T]) R) []R {
+	res := make([]R, len(fs))
+	chans := make([]chan T, len(fs))
+	dones := make([]chan struct{}, len(fs))
+	var wg sync.WaitGroup
+	wg.Add(len(fs))
+	for i, f := range fs {
+		chans[i] = make(chan T)
+		dones[i] = make(chan struct{})
+		go func(i int, f func(it Iterable[T]) R, ch <-chan T) {
+			defer wg.Done()
+			defer close(dones[i])
+			res[i] = f(FromChan(ch))
+		}(i, f, chans[i])
+	}
+
+	live := len(fs)
+	for live > 0 {
+		v, ok := it.TryNext()
+		if !ok {
+			break
+		}
+		for i, ch := range chans {
+			if ch == nil {
+				continue
+			}
+			select {
+			case ch <- v:
+			case <-dones[i]:
+				chans[i] = nil
+				live--
+			}
+		}
+	}
+	for _, ch := range chans {
+		if ch != nil {
+			close(ch)
+		}
+	}
+	wg.Wait()
+	return res
+}
+
+// Broadcast2 is like Broadcast for two consumers with different result
+// types.
+func Broadcast2[T any, A any, B any](it Iterable[T], fa func(it Iterable[T]) A, fb func(it Iterable[T]) B) Tuple[A, B] {
+	res := Broadcast(it,
+		func(it Iterable[T]) any {
+			return fa(it)
+		},
+		func(it Iterable[T]) any {
+			return fb(it)
+		},
+	)
+	a, _ := res[0].(A)
+	b, _ := res[1].(B)
+	return Tuple[A, B]{a, b}
+}
@@ -0,0 +1,123 @@
+package iterable_test
+
+import (
+	"github.com/sergeychunayev/gofu/pkg/iterable"
+	"github.com/stretchr/testify/require"
+	"sync"
+	"testing"
+)
+
+// counting wraps a slice and counts how many elements were pulled from it.
+func counting(arr []int, pulled *int) iterable.Iterable[int] {
+	return iterable.Map(iterable.New(arr), func(v int) int {
+		*pulled++
+		return v
+	})
+}
+
+func TestTee(t *testing.T) {
+	pulled := 0
+	its := iterable.Tee(counting([]int{1, 2, 3, 4}, &pulled), 2)
+	require.Len(t, its, 2)
+
+	require.Equal(t, 1, its[0].Next())
+	require.Equal(t, 2, its[0].Next())
+	require.Equal(t, 2, pulled)
+
+	require.Equal(t, []int{1, 2, 3, 4}, its[1].ToSlice())
+	require.Equal(t, 4, pulled)
+	require.Equal(t, []int{3, 4}, its[0].ToSlice())
+	require.Equal(t, 4, pulled)
+}
+
+func TestTee_GroupByAndReduce(t *testing.T) {
+	its := iterable.Tee(iterable.New([]int{1, 2, 3, 4, 5}), 2)
+	groups := iterable.GroupBy(its[0], func(v int) bool {
+		return v%2 == 0
+	})
+	sum, ok := its[1].Reduce(func(acc int, v int) int {
+		return acc + v
+	})
+	require.Equal(t, map[bool][]int{false: {1, 3, 5}, true: {2, 4}}, groups)
+	require.True(t, ok)
+	require.Equal(t, 15, sum)
+}
+
+func TestTee_Empty(t *testing.T) {
+	its := iterable.Tee(iterable.New([]int{}), 3)
+	for _, it := range its {
+		require.False(t, it.HasNext())
+	}
+}
+
+func TestTee_Concurrent(t *testing.T) {
+	arr := make([]int, 1000)
+	for i := range arr {
+		arr[i] = i
+	}
+	its := iterable.Tee(iterable.New(arr), 4)
+	res := make([][]int, len(its))
+	var wg sync.WaitGroup
+	for i, it := range its {
+		wg.Add(1)
+		go func(i int, it iterable.Iterable[int]) {
+			defer wg.Done()
+			res[i] = it.ToSlice()
+		}(i, it)
+	}
+	wg.Wait()
+	for _, r := range res {
+		require.Equal(t, arr, r)
+	}
+}
+
+func TestBroadcast(t *testing.T) {
+	pulled := 0
+	res := iterable.Broadcast(counting([]int{1, 2, 3, 4}, &pulled),
+		func(it iterable.Iterable[int]) int {
+			res, _ := it.Reduce(func(acc int, v int) int {
+				return acc + v
+			})
+			return res
+		},
+		func(it iterable.Iterable[int]) int {
+			return len(it.ToSlice())
+		},
+		func(it iterable.Iterable[int]) int {
+			if it.Any(func(v int) bool {
+				return v == 2
+			}) {
+				return 1
+			}
+			return 0
+		},
+	)
+	require.Equal(t, []int{10, 4, 1}, res)
+	require.Equal(t, 4, pulled)
+}
+
+func TestBroadcast_AllStopEarly(t *testing.T) {
+	pulled := 0
+	first := func(it iterable.Iterable[int]) int {
+		return it.Next()
+	}
+	res := iterable.Broadcast(counting([]int{1, 2, 3, 4, 5, 6}, &pulled), first, first)
+	require.Equal(t, []int{1, 1}, res)
+	require.LessOrEqual(t, pulled, 2)
+}
+
+func TestBroadcast2(t *testing.T) {
+	res := iterable.Broadcast2(iterable.New([]int{1, 2, 3}),
+		func(it iterable.Iterable[int]) map[bool][]int {
+			return iterable.GroupBy(it, func(v int) bool {
+				return v > 1
+			})
+		},
+		func(it iterable.Iterable[int]) error {
+			it.ToSlice()
+			return nil
+		},
+	)
+	require.Equal(t, map[bool][]int{false: {1}, true: {2, 3}}, res.A)
+	require.NoError(t, res.B)
+}
@@ -2,17 +2,26 @@ package iterable
 
 import "sort"
 
+// mustNext implements Next on top of TryNext.
+func mustNext[T any](it Iterable[T]) T {
+	res, ok := it.TryNext()
+	if !ok {
+		panic(ErrExhausted)
+	}
+	return res
+}
+
 func doFor[T any](it Iterable[T], f func(v T, i int)) {
 	i := 0
-	for it.HasNext() {
-		f(it.Next(), i)
+	for v, ok := it.TryNext(); ok; v, ok = it.TryNext() {
+		f(v, i)
 		i++
 	}
 }
 
 func all[T any](it Iterable[T], f func(v T) bool) bool {
-	for it.HasNext() {
-		if !f(it.Next()) {
+	for v, ok := it.TryNext(); ok; v, ok = it.TryNext() {
+		if !f(v) {
 			return false
 		}
 	}
@@ -20,8 +29,8 @@ func all[T any](it Iterable[T], f func(v T) bool) bool {
 }
 
 func doAny[T any](it Iterable[T], f func(v T) bool) bool {
-	for it.HasNext() {
-		if f(it.Next()) {
+	for v, ok := it.TryNext(); ok; v, ok = it.TryNext() {
+		if f(v) {
 			return true
 		}
 	}
@@ -47,13 +56,11 @@ func max[T any](it Iterable[T], cmp func(a T, b T) bool) (T, bool) {
 }
 
 func reduce[T any](it Iterable[T], f func(acc T, v T) T) (T, bool) {
-	var res T
-	if !it.HasNext() {
-		return res, false
+	first, ok := it.TryNext()
+	if !ok {
+		return first, false
 	}
-
-	res = Fold[T, T](it, f, it.Next())
-	return res, true
+	return Fold[T, T](it, f, first), true
 }
 
 func doSort[T any](it Iterable[T], less func(a T, b T) bool) Iterable[T] {
@@ -65,14 +72,107 @@ func doSort[T any](it Iterable[T], less func(a T, b T) bool) Iterable[T] {
 }
 
 func cycle[T any](it Iterable[T]) Iterable[T] {
-	var res = it.ToSlice()
-	return New(res).Cycle()
+	return newCycle(it, -1)
 }
 
 func toSlice[T any](it Iterable[T]) []T {
 	var res []T
-	for it.HasNext() {
-		res = append(res, it.Next())
+	if n := capHint(it); n > 0 {
+		res = make([]T, 0, n)
+	}
+	for v, ok := it.TryNext(); ok; v, ok = it.TryNext() {
+		res = append(res, v)
+	}
+	if len(res) == 0 {
+		return nil
 	}
 	return res
 }
+
+func count[T any](it Iterable[T]) int {
+	res := 0
+	for _, ok := it.TryNext(); ok; _, ok = it.TryNext() {
+		res++
+	}
+	return res
+}
+
+// capHint returns the capacity to preallocate for the elements of it. Only
+// exact sizes are used: an upper bound such as that of a Filter may be far
+// larger than the result, whose backing array would keep the excess alive.
+func capHint[T any](it Iterable[T]) int {
+	n, ok := Len(it)
+	if !ok {
+		return 0
+	}
+	return n
+}
+
+func reverse[T any](it Iterable[T]) Iterable[T] {
+	if de, ok := it.(DoubleEnded[T]); ok {
+		return &reverseIterable[T]{de}
+	}
+
+	res := toSlice(it)
+	for i, j := 0, len(res)-1; i < j; i, j = i+1, j-1 {
+		res[i], res[j] = res[j], res[i]
+	}
+	return New(res)
+}
+
+func last[T any](it Iterable[T]) (T, bool) {
+	var res T
+	if de, ok := it.(DoubleEnded[T]); ok {
+		if !de.HasNext() {
+			return res, false
+		}
+		return de.NextBack(), true
+	}
+
+	found := false
+	for v, ok := it.TryNext(); ok; v, ok = it.TryNext() {
+		res = v
+		found = true
+	}
+	return res, found
+}
+
+func takeLast[T any](it Iterable[T], n int) Iterable[T] {
+	if n <= 0 {
+		return New[T](nil)
+	}
+
+	// n may be far larger than it, so preallocate only for a known size
+	size := capHint(it)
+	if size > n {
+		size = n
+	}
+
+	if de, ok := it.(DoubleEnded[T]); ok {
+		res := make([]T, 0, size)
+		for len(res) < n && de.HasNext() {
+			res = append(res, de.NextBack())
+		}
+		for i, j := 0, len(res)-1; i < j; i, j = i+1, j-1 {
+			res[i], res[j] = res[j], res[i]
+		}
+		return New(res)
+	}
+
+	// keep the last n elements in a ring buffer, which grows until it first
+	// holds n of them
+	buf := make([]T, 0, size)
+	start := 0
+	for v, ok := it.TryNext(); ok; v, ok = it.TryNext() {
+		if len(buf) < n {
+			buf = append(buf, v)
+			continue
+		}
+		buf[start] = v
+		start = (start + 1) % n
+	}
+	res := make([]T, 0, len(buf))
+	res = append(res, buf[start:]...)
+	res = append(res, buf[:start]...)
+	return New(res)
+}
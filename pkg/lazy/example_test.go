@@ -0,0 +1,21 @@
+package lazy_test
+
+import (
+	"fmt"
+	"github.com/sergeychunayev/gofu/pkg/lazy"
+)
+
+func ExampleNew() {
+	l := lazy.New(func() int {
+		fmt.Println("computing")
+		return 42
+	})
+	fmt.Println("created")
+	fmt.Println(l.Get())
+	fmt.Println(l.Get())
+	// Output:
+	// created
+	// computing
+	// 42
+	// 42
+}
@@ -0,0 +1,80 @@
+package lazy
+
+import (
+	"github.com/sergeychunayev/gofu/pkg/iterable"
+	"github.com/sergeychunayev/gofu/pkg/option"
+	"sync"
+)
+
+// Lazy is a value computed on the first call to Get. The computation runs
+// exactly once even when Get is called from several goroutines; later calls
+// return the stored value. If the computation panics, every call to Get
+// panics with the same value.
+type Lazy[T any] struct {
+	once   sync.Once
+	f      func() T
+	v      T
+	panicV any
+}
+
+// New returns a Lazy that computes its value with f.
+func New[T any](f func() T) *Lazy[T] {
+	return &Lazy[T]{f: f}
+}
+
+// Of returns a Lazy that already holds v.
+func Of[T any](v T) *Lazy[T] {
+	l := &Lazy[T]{v: v}
+	l.once.Do(func() {})
+	return l
+}
+
+func (l *Lazy[T]) Get() T {
+	l.once.Do(func() {
+		defer func() {
+			if r := recover(); r != nil {
+				l.panicV = r
+			}
+		}()
+		l.v = l.f()
+		l.f = nil
+	})
+	if l.panicV != nil {
+		panic(l.panicV)
+	}
+	return l.v
+}
+
+// Map returns a Lazy that applies f to the value of l when its own value is
+// first requested.
+func Map[T any, U any](l *Lazy[T], f func(v T) U) *Lazy[U] {
+	return New(func() U {
+		return f(l.Get())
+	})
+}
+
+// FlatMap returns a Lazy that holds the value of the Lazy returned by f.
+func FlatMap[T any, U any](l *Lazy[T], f func(v T) *Lazy[U]) *Lazy[U] {
+	return New(func() U {
+		return f(l.Get()).Get()
+	})
+}
+
+// ToIterable returns an Iterable with the value of l as its only element.
+// The value is not computed until the Iterable is consumed.
+func ToIterable[T any](l *Lazy[T]) iterable.Iterable[T] {
+	done := false
+	return iterable.FromFunc(func() (T, bool) {
+		if done {
+			var zero T
+			return zero, false
+		}
+		done = true
+		return l.Get(), true
+	})
+}
+
+// ToOption computes the value of l and returns it as Some.
+func ToOption[T any](l *Lazy[T]) option.Option[T] {
+	return option.Of(l.Get())
+}
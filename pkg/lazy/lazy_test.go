@@ -0,0 +1,144 @@
+package lazy
+
+import (
+	"errors"
+	"github.com/sergeychunayev/gofu/pkg/option"
+	"github.com/stretchr/testify/require"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+var errTest = errors.New("test")
+
+func counter(v int) (*Lazy[int], *int32) {
+	var calls int32
+	return New(func() int {
+		atomic.AddInt32(&calls, 1)
+		return v
+	}), &calls
+}
+
+func TestLazy_Get(t *testing.T) {
+	l, calls := counter(1)
+	require.Equal(t, int32(0), atomic.LoadInt32(calls))
+	require.Equal(t, 1, l.Get())
+	require.Equal(t, 1, l.Get())
+	require.Equal(t, int32(1), atomic.LoadInt32(calls))
+}
+
+func TestLazy_Concurrent(t *testing.T) {
+	l, calls := counter(1)
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			require.Equal(t, 1, l.Get())
+		}()
+	}
+	wg.Wait()
+	require.Equal(t, int32(1), atomic.LoadInt32(calls))
+}
+
+func TestLazy_Panic(t *testing.T) {
+	calls := 0
+	l := New(func() int {
+		calls++
+		panic("boom")
+	})
+	require.PanicsWithValue(t, "boom", func() {
+		l.Get()
+	})
+	require.PanicsWithValue(t, "boom", func() {
+		l.Get()
+	})
+	require.Equal(t, 1, calls)
+}
+
+func TestOf(t *testing.T) {
+	require.Equal(t, 1, Of(1).Get())
+}
+
+func TestMap(t *testing.T) {
+	l, calls := counter(1)
+	m := Map(l, strconv.Itoa)
+	require.Equal(t, int32(0), atomic.LoadInt32(calls))
+	require.Equal(t, "1", m.Get())
+	require.Equal(t, 1, l.Get())
+	require.Equal(t, int32(1), atomic.LoadInt32(calls))
+}
+
+func TestFlatMap(t *testing.T) {
+	l, calls := counter(2)
+	m := FlatMap(l, func(v int) *Lazy[int] {
+		return Of(v * 2)
+	})
+	require.Equal(t, int32(0), atomic.LoadInt32(calls))
+	require.Equal(t, 4, m.Get())
+}
+
+func TestToIterable(t *testing.T) {
+	l, calls := counter(1)
+	it := ToIterable(l)
+	require.Equal(t, int32(0), atomic.LoadInt32(calls))
+	require.Equal(t, []int{1}, it.ToSlice())
+	require.False(t, it.HasNext())
+	require.Equal(t, int32(1), atomic.LoadInt32(calls))
+}
+
+func TestToOption(t *testing.T) {
+	require.Equal(t, option.Of(1), ToOption(Of(1)))
+}
+
+func TestTry(t *testing.T) {
+	calls := 0
+	tr := NewTry(func() (int, error) {
+		calls++
+		return strconv.Atoi("x")
+	})
+	_, err := tr.Get()
+	require.Error(t, err)
+	_, err = tr.Get()
+	require.Error(t, err)
+	require.Equal(t, 1, calls)
+}
+
+func TestMapTry(t *testing.T) {
+	ok := NewTry(func() (string, error) {
+		return "12", nil
+	})
+	v, err := MapTry(ok, strconv.Atoi).Get()
+	require.NoError(t, err)
+	require.Equal(t, 12, v)
+
+	failed := NewTry(func() (string, error) {
+		return "", errTest
+	})
+	_, err = MapTry(failed, strconv.Atoi).Get()
+	require.ErrorIs(t, err, errTest)
+}
+
+func TestTryToIterable(t *testing.T) {
+	it, errF := TryToIterable(NewTry(func() (int, error) {
+		return 1, nil
+	}))
+	require.Equal(t, []int{1}, it.ToSlice())
+	require.NoError(t, errF())
+
+	it, errF = TryToIterable(NewTry(func() (int, error) {
+		return 0, errTest
+	}))
+	require.Empty(t, it.ToSlice())
+	require.ErrorIs(t, errF(), errTest)
+}
+
+func TestTryToOption(t *testing.T) {
+	require.Equal(t, option.Of(1), TryToOption(NewTry(func() (int, error) {
+		return 1, nil
+	})))
+	require.Equal(t, option.No[int](), TryToOption(NewTry(func() (int, error) {
+		return 1, errTest
+	})))
+}
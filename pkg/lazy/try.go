@@ -0,0 +1,77 @@
+package lazy
+
+import (
+	"github.com/sergeychunayev/gofu/pkg/iterable"
+	"github.com/sergeychunayev/gofu/pkg/option"
+)
+
+// Try is a Lazy whose computation may fail. Both the value and the error are
+// computed once and returned by every call to Get.
+type Try[T any] struct {
+	l *Lazy[tryResult[T]]
+}
+
+type tryResult[T any] struct {
+	v   T
+	err error
+}
+
+// NewTry returns a Try that computes its value with f.
+func NewTry[T any](f func() (T, error)) *Try[T] {
+	return &Try[T]{New(func() tryResult[T] {
+		v, err := f()
+		return tryResult[T]{v, err}
+	})}
+}
+
+func (t *Try[T]) Get() (T, error) {
+	r := t.l.Get()
+	return r.v, r.err
+}
+
+// MapTry returns a Try that applies f to the value of t if its computation
+// succeeds.
+func MapTry[T any, U any](t *Try[T], f func(v T) (U, error)) *Try[U] {
+	return NewTry(func() (U, error) {
+		v, err := t.Get()
+		if err != nil {
+			var zero U
+			return zero, err
+		}
+		return f(v)
+	})
+}
+
+// TryToIterable returns an Iterable with the value of t as its only element,
+// or no elements if the computation fails. The returned function reports the
+// error once the Iterable is consumed.
+func TryToIterable[T any](t *Try[T]) (iterable.Iterable[T], func() error) {
+	done := false
+	var err error
+	it := iterable.FromFunc(func() (T, bool) {
+		var zero T
+		if done {
+			return zero, false
+		}
+		done = true
+		var v T
+		v, err = t.Get()
+		if err != nil {
+			return zero, false
+		}
+		return v, true
+	})
+	return it, func() error {
+		return err
+	}
+}
+
+// TryToOption computes the value of t and returns it as Some, or None if the
+// computation fails.
+func TryToOption[T any](t *Try[T]) option.Option[T] {
+	v, err := t.Get()
+	if err != nil {
+		return option.No[T]()
+	}
+	return option.Of(v)
+}
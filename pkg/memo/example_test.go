@@ -0,0 +1,22 @@
+package memo_test
+
+import (
+	"fmt"
+	"github.com/sergeychunayev/gofu/pkg/iterable"
+	"github.com/sergeychunayev/gofu/pkg/memo"
+)
+
+func ExampleMemoize() {
+	square := memo.Memoize(func(v int) int {
+		fmt.Println("computing", v)
+		return v * v
+	}, memo.MaxSize(100))
+	res := iterable.Map(iterable.New([]int{2, 3, 2, 2}), square.Get).ToSlice()
+	fmt.Println(res)
+	fmt.Printf("%+v\n", square.Stats())
+	// Output:
+	// computing 2
+	// computing 3
+	// [4 9 4 4]
+	// {Hits:2 Misses:2 Evictions:0 Expirations:0}
+}
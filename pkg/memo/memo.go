@@ -0,0 +1,201 @@
+package memo
+
+import (
+	"container/list"
+	"github.com/sergeychunayev/gofu/pkg/clock"
+	"sync"
+	"time"
+)
+
+// Option configures a Memo.
+type Option func(c *config)
+
+type config struct {
+	maxSize int
+	ttl     time.Duration
+	clock   clock.Clock
+}
+
+// MaxSize bounds the number of cached results. Once it is reached the least
+// recently used result is evicted. By default the cache is unbounded.
+func MaxSize(n int) Option {
+	return func(c *config) {
+		c.maxSize = n
+	}
+}
+
+// TTL expires cached results d after they were computed. By default results
+// never expire.
+func TTL(d time.Duration) Option {
+	return func(c *config) {
+		c.ttl = d
+	}
+}
+
+// WithClock makes TTL expiry measure time with clk instead of the real
+// clock.
+func WithClock(clk clock.Clock) Option {
+	return func(c *config) {
+		c.clock = clk
+	}
+}
+
+// Stats counts the calls made to a Memo.
+type Stats struct {
+	// Hits counts calls answered from the cache or by waiting for a
+	// concurrent call with the same key.
+	Hits uint64
+	// Misses counts calls that invoked the memoized function.
+	Misses uint64
+	// Evictions counts results dropped because the cache was full.
+	Evictions uint64
+	// Expirations counts results dropped because their TTL had passed.
+	Expirations uint64
+}
+
+// Memo caches the results of a function. It is safe for concurrent use;
+// concurrent calls with the same uncached key invoke the function once and
+// share its result.
+type Memo[K comparable, V any] struct {
+	f   func(k K) V
+	cfg *config
+
+	mu       sync.Mutex
+	entries  map[K]*list.Element
+	lru      *list.List
+	inFlight map[K]*call[V]
+	stats    Stats
+}
+
+type entry[K comparable, V any] struct {
+	k       K
+	v       V
+	expires time.Time
+}
+
+type call[V any] struct {
+	done   chan struct{}
+	v      V
+	panicV any
+}
+
+// Memoize returns a Memo that caches the results of f. Pass its Get method
+// to iterable.Map.
+func Memoize[K comparable, V any](f func(k K) V, opts ...Option) *Memo[K, V] {
+	cfg := &config{clock: clock.Real()}
+	for _, o := range opts {
+		o(cfg)
+	}
+	return &Memo[K, V]{
+		f:        f,
+		cfg:      cfg,
+		entries:  make(map[K]*list.Element),
+		lru:      list.New(),
+		inFlight: make(map[K]*call[V]),
+	}
+}
+
+// Get returns the cached result for k, computing it with the memoized
+// function if needed. If the function panics, the result is not cached and
+// every call waiting for it panics with the same value.
+func (m *Memo[K, V]) Get(k K) V {
+	m.mu.Lock()
+	if v, ok := m.lookup(k); ok {
+		m.stats.Hits++
+		m.mu.Unlock()
+		return v
+	}
+	if c, ok := m.inFlight[k]; ok {
+		m.stats.Hits++
+		m.mu.Unlock()
+		<-c.done
+		if c.panicV != nil {
+			panic(c.panicV)
+		}
+		return c.v
+	}
+	c := &call[V]{done: make(chan struct{})}
+	m.inFlight[k] = c
+	m.stats.Misses++
+	m.mu.Unlock()
+
+	defer func() {
+		if r := recover(); r != nil {
+			c.panicV = r
+		}
+		m.mu.Lock()
+		delete(m.inFlight, k)
+		if c.panicV == nil {
+			m.store(k, c.v)
+		}
+		m.mu.Unlock()
+		close(c.done)
+		if c.panicV != nil {
+			panic(c.panicV)
+		}
+	}()
+	c.v = m.f(k)
+	return c.v
+}
+
+// Forget removes the cached result for k.
+func (m *Memo[K, V]) Forget(k K) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if el, ok := m.entries[k]; ok {
+		m.remove(el)
+	}
+}
+
+// Len returns the number of cached results, including expired ones that
+// have not been looked up since.
+func (m *Memo[K, V]) Len() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.lru.Len()
+}
+
+func (m *Memo[K, V]) Stats() Stats {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.stats
+}
+
+func (m *Memo[K, V]) lookup(k K) (V, bool) {
+	el, ok := m.entries[k]
+	if !ok {
+		var zero V
+		return zero, false
+	}
+	e := el.Value.(*entry[K, V])
+	if m.cfg.ttl > 0 && !m.cfg.clock.Now().Before(e.expires) {
+		m.remove(el)
+		m.stats.Expirations++
+		var zero V
+		return zero, false
+	}
+	m.lru.MoveToFront(el)
+	return e.v, true
+}
+
+func (m *Memo[K, V]) store(k K, v V) {
+	e := &entry[K, V]{k: k, v: v}
+	if m.cfg.ttl > 0 {
+		e.expires = m.cfg.clock.Now().Add(m.cfg.ttl)
+	}
+	if el, ok := m.entries[k]; ok {
+		el.Value = e
+		m.lru.MoveToFront(el)
+		return
+	}
+	m.entries[k] = m.lru.PushFront(e)
+	if m.cfg.maxSize > 0 && m.lru.Len() > m.cfg.maxSize {
+		m.remove(m.lru.Back())
+		m.stats.Evictions++
+	}
+}
+
+func (m *Memo[K, V]) remove(el *list.Element) {
+	m.lru.Remove(el)
+	delete(m.entries, el.Value.(*entry[K, V]).k)
+}
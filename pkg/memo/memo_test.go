@@ -0,0 +1,146 @@
+package memo
+
+import (
+	"github.com/sergeychunayev/gofu/pkg/clock"
+	"github.com/stretchr/testify/require"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func counting() (func(v int) int, *int32) {
+	var calls int32
+	return func(v int) int {
+		atomic.AddInt32(&calls, 1)
+		return v * 2
+	}, &calls
+}
+
+func TestMemo_Get(t *testing.T) {
+	f, calls := counting()
+	m := Memoize(f)
+	for _, k := range []int{1, 2, 1, 1, 2, 3} {
+		require.Equal(t, k*2, m.Get(k))
+	}
+	require.Equal(t, int32(3), *calls)
+	require.Equal(t, Stats{Hits: 3, Misses: 3}, m.Stats())
+	require.Equal(t, 3, m.Len())
+}
+
+func TestMemo_MaxSize(t *testing.T) {
+	f, calls := counting()
+	m := Memoize(f, MaxSize(2))
+	m.Get(1)
+	m.Get(2)
+	m.Get(1) // 2 is now the least recently used
+	m.Get(3)
+	require.Equal(t, 2, m.Len())
+	require.Equal(t, int32(3), *calls)
+
+	m.Get(1)
+	require.Equal(t, int32(3), *calls)
+	m.Get(2)
+	require.Equal(t, int32(4), *calls)
+	require.Equal(t, Stats{Hits: 2, Misses: 4, Evictions: 2}, m.Stats())
+}
+
+func TestMemo_TTL(t *testing.T) {
+	clk := clock.NewFake(time.Time{})
+	f, calls := counting()
+	m := Memoize(f, TTL(time.Second), WithClock(clk))
+	m.Get(1)
+	clk.Advance(999 * time.Millisecond)
+	m.Get(1)
+	require.Equal(t, int32(1), *calls)
+
+	clk.Advance(time.Millisecond)
+	m.Get(1)
+	require.Equal(t, int32(2), *calls)
+	require.Equal(t, Stats{Hits: 1, Misses: 2, Expirations: 1}, m.Stats())
+}
+
+func TestMemo_Forget(t *testing.T) {
+	f, calls := counting()
+	m := Memoize(f)
+	m.Get(1)
+	m.Forget(1)
+	m.Forget(2)
+	require.Zero(t, m.Len())
+	m.Get(1)
+	require.Equal(t, int32(2), *calls)
+}
+
+func TestMemo_SingleFlight(t *testing.T) {
+	const n = 10
+	started := make(chan struct{})
+	release := make(chan struct{})
+	var calls int32
+	m := Memoize(func(v int) int {
+		atomic.AddInt32(&calls, 1)
+		close(started)
+		<-release
+		return v * 2
+	})
+
+	var wg sync.WaitGroup
+	res := make([]int, n)
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		res[0] = m.Get(1)
+	}()
+	<-started
+	for i := 1; i < n; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			res[i] = m.Get(1)
+		}(i)
+	}
+	require.Eventually(t, func() bool {
+		return m.Stats().Hits == n-1
+	}, time.Second, time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	require.Equal(t, int32(1), atomic.LoadInt32(&calls))
+	for _, v := range res {
+		require.Equal(t, 2, v)
+	}
+}
+
+func TestMemo_Panic(t *testing.T) {
+	calls := 0
+	m := Memoize(func(v int) int {
+		calls++
+		if calls == 1 {
+			panic("boom")
+		}
+		return v
+	})
+	require.PanicsWithValue(t, "boom", func() {
+		m.Get(1)
+	})
+	require.Zero(t, m.Len())
+	require.Equal(t, 1, m.Get(1))
+}
+
+func TestMemo_Concurrent(t *testing.T) {
+	f, _ := counting()
+	m := Memoize(f, MaxSize(4))
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for k := 0; k < 100; k++ {
+				require.Equal(t, (k+i)%10*2, m.Get((k+i)%10))
+			}
+		}(i)
+	}
+	wg.Wait()
+	s := m.Stats()
+	require.Equal(t, uint64(800), s.Hits+s.Misses)
+	require.LessOrEqual(t, m.Len(), 4)
+}
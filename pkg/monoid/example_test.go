@@ -0,0 +1,28 @@
+package monoid_test
+
+import (
+	"fmt"
+	"github.com/sergeychunayev/gofu/pkg/iterable"
+	"github.com/sergeychunayev/gofu/pkg/monoid"
+	"github.com/sergeychunayev/gofu/pkg/option"
+)
+
+func ExampleFoldMap() {
+	words := iterable.New([]string{"go", "functional", "fu"})
+	longest := monoid.FoldMap(words, func(s string) option.Option[int] {
+		return option.Of(len(s))
+	}, monoid.Option(monoid.Max[int]()))
+	fmt.Println(longest)
+	// Output: Some(10)
+}
+
+func ExampleGroupBy() {
+	words := iterable.New([]string{"apple", "avocado", "banana"})
+	res := monoid.GroupBy(words, func(s string) string {
+		return s[:1]
+	}, func(s string) int {
+		return len(s)
+	}, monoid.Sum[int]())
+	fmt.Println(res)
+	// Output: map[a:12 b:6]
+}
@@ -0,0 +1,37 @@
+package monoid
+
+import "github.com/sergeychunayev/gofu/pkg/iterable"
+
+// Fold combines the elements of it with m, returning Empty for an empty
+// Iterable.
+func Fold[T any](it iterable.Iterable[T], m Monoid[T]) T {
+	return FoldMap(it, func(v T) T {
+		return v
+	}, m)
+}
+
+// FoldMap maps every element of it with f and combines the results with m.
+func FoldMap[T any, U any](it iterable.Iterable[T], f func(v T) U, m Monoid[U]) U {
+	combine := combineInto(m)
+	res := m.Empty()
+	for v, ok := it.TryNext(); ok; v, ok = it.TryNext() {
+		res = combine(res, f(v))
+	}
+	return res
+}
+
+// GroupBy groups the elements of it by keyF, maps each of them with f and
+// combines the results of every group with m.
+func GroupBy[T any, K comparable, U any](it iterable.Iterable[T], keyF func(v T) K, f func(v T) U, m Monoid[U]) map[K]U {
+	combine := combineInto(m)
+	res := make(map[K]U)
+	for v, ok := it.TryNext(); ok; v, ok = it.TryNext() {
+		k := keyF(v)
+		acc, ok := res[k]
+		if !ok {
+			acc = m.Empty()
+		}
+		res[k] = combine(acc, f(v))
+	}
+	return res
+}
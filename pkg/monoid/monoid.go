@@ -0,0 +1,230 @@
+package monoid
+
+import (
+	"github.com/sergeychunayev/gofu/pkg/iterable/ord"
+	"github.com/sergeychunayev/gofu/pkg/option"
+)
+
+// Semigroup combines two values into one. Combine must be associative.
+type Semigroup[T any] interface {
+	Combine(a T, b T) T
+}
+
+// Monoid is a Semigroup with an identity element: combining any value with
+// Empty returns the value unchanged.
+type Monoid[T any] interface {
+	Semigroup[T]
+
+	Empty() T
+}
+
+// SemigroupFunc adapts an associative function to Semigroup.
+type SemigroupFunc[T any] func(a T, b T) T
+
+func (f SemigroupFunc[T]) Combine(a T, b T) T {
+	return f(a, b)
+}
+
+type monoid[T any] struct {
+	empty   T
+	combine func(a T, b T) T
+}
+
+func (m monoid[T]) Empty() T {
+	return m.empty
+}
+
+func (m monoid[T]) Combine(a T, b T) T {
+	return m.combine(a, b)
+}
+
+// New returns a Monoid with the given identity and associative combine
+// function.
+func New[T any](empty T, combine func(a T, b T) T) Monoid[T] {
+	return monoid[T]{empty, combine}
+}
+
+type Number interface {
+	~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~float32 | ~float64 |
+		~complex64 | ~complex128
+}
+
+func Sum[T Number]() Monoid[T] {
+	return New(0, func(a T, b T) T {
+		return a + b
+	})
+}
+
+func Product[T Number]() Monoid[T] {
+	return New(1, func(a T, b T) T {
+		return a * b
+	})
+}
+
+// Min has no identity element in general, so it is only a Semigroup. Lift
+// it with Option to fold possibly empty Iterables.
+func Min[T ord.Ord]() Semigroup[T] {
+	return SemigroupFunc[T](ord.Min[T])
+}
+
+// Max has no identity element in general, so it is only a Semigroup. Lift
+// it with Option to fold possibly empty Iterables.
+func Max[T ord.Ord]() Semigroup[T] {
+	return SemigroupFunc[T](ord.Max[T])
+}
+
+// First keeps the leftmost value.
+func First[T any]() Semigroup[T] {
+	return SemigroupFunc[T](func(a T, _ T) T {
+		return a
+	})
+}
+
+// Last keeps the rightmost value.
+func Last[T any]() Semigroup[T] {
+	return SemigroupFunc[T](func(_ T, b T) T {
+		return b
+	})
+}
+
+// All is the Monoid of logical conjunction.
+func All() Monoid[bool] {
+	return New(true, func(a bool, b bool) bool {
+		return a && b
+	})
+}
+
+// Any is the Monoid of logical disjunction.
+func Any() Monoid[bool] {
+	return New(false, func(a bool, b bool) bool {
+		return a || b
+	})
+}
+
+// String is the Monoid of string concatenation.
+func String() Monoid[string] {
+	return New("", func(a string, b string) string {
+		return a + b
+	})
+}
+
+// Accumulator is implemented by Monoids that can add a value to an
+// accumulator without copying it. Fold, FoldMap, GroupBy and Concat own their
+// accumulators and use it when available, so that combining n slices or maps
+// takes linear rather than quadratic time.
+type Accumulator[T any] interface {
+	// CombineInto returns Combine(acc, v), possibly by modifying acc. acc
+	// must be Empty or a previous result of CombineInto; v is not modified
+	// and the result never shares storage with it.
+	CombineInto(acc T, v T) T
+}
+
+type sliceMonoid[T any] struct{}
+
+func (sliceMonoid[T]) Empty() []T {
+	return nil
+}
+
+func (sliceMonoid[T]) Combine(a []T, b []T) []T {
+	if len(a) == 0 {
+		return b
+	}
+	if len(b) == 0 {
+		return a
+	}
+	res := make([]T, 0, len(a)+len(b))
+	res = append(res, a...)
+	return append(res, b...)
+}
+
+func (sliceMonoid[T]) CombineInto(acc []T, v []T) []T {
+	return append(acc, v...)
+}
+
+// Slice is the Monoid of slice concatenation. Combine never modifies its
+// arguments, but the result may be one of them if the other is empty. It
+// implements Accumulator.
+func Slice[T any]() Monoid[[]T] {
+	return sliceMonoid[T]{}
+}
+
+type mapMonoid[K comparable, V any] struct {
+	values Semigroup[V]
+}
+
+func (mapMonoid[K, V]) Empty() map[K]V {
+	return nil
+}
+
+func (m mapMonoid[K, V]) Combine(a map[K]V, b map[K]V) map[K]V {
+	if len(a) == 0 {
+		return b
+	}
+	if len(b) == 0 {
+		return a
+	}
+	res := make(map[K]V, len(a)+len(b))
+	for k, v := range a {
+		res[k] = v
+	}
+	return m.CombineInto(res, b)
+}
+
+func (m mapMonoid[K, V]) CombineInto(acc map[K]V, v map[K]V) map[K]V {
+	if len(v) == 0 {
+		return acc
+	}
+	if acc == nil {
+		acc = make(map[K]V, len(v))
+	}
+	for k, x := range v {
+		if prev, ok := acc[k]; ok {
+			x = m.values.Combine(prev, x)
+		}
+		acc[k] = x
+	}
+	return acc
+}
+
+// Map is the Monoid of map union. Values present in both maps are combined
+// with values. Combine never modifies its arguments, but the result may be
+// one of them if the other is empty. It implements Accumulator.
+func Map[K comparable, V any](values Semigroup[V]) Monoid[map[K]V] {
+	return mapMonoid[K, V]{values}
+}
+
+// Option lifts a Semigroup to a Monoid over option.Option with None as the
+// identity. For example, Option(Min[int]()) folds to None for an empty
+// Iterable and to Some of the minimum otherwise.
+func Option[T any](s Semigroup[T]) Monoid[option.Option[T]] {
+	return New(option.No[T](), func(a option.Option[T], b option.Option[T]) option.Option[T] {
+		if a.IsNone() {
+			return b
+		}
+		if b.IsNone() {
+			return a
+		}
+		return option.Of(s.Combine(a.Unwrap(), b.Unwrap()))
+	})
+}
+
+// Concat combines values with m, returning Empty if there are none.
+func Concat[T any](m Monoid[T], values ...T) T {
+	combine := combineInto(m)
+	res := m.Empty()
+	for _, v := range values {
+		res = combine(res, v)
+	}
+	return res
+}
+
+// combineInto returns the in-place combine of m if it is an Accumulator and
+// its Combine otherwise.
+func combineInto[T any](m Monoid[T]) func(acc T, v T) T {
+	if a, ok := m.(Accumulator[T]); ok {
+		return a.CombineInto
+	}
+	return m.Combine
+}
@@ -0,0 +1,53 @@
+package monoid
+
+import (
+	"github.com/sergeychunayev/gofu/pkg/iterable"
+	"testing"
+)
+
+const benchSize = 1000
+
+// copying hides the Accumulator implementation of m, so that folds fall back
+// to Combine and copy the accumulator on every step.
+func copying[T any](m Monoid[T]) Monoid[T] {
+	return New(m.Empty(), m.Combine)
+}
+
+func benchmarkFoldMapSlice(b *testing.B, m Monoid[[]int]) {
+	input := make([]int, benchSize)
+	for i := 0; i < b.N; i++ {
+		FoldMap(iterable.New(input), func(v int) []int {
+			return []int{v}
+		}, m)
+	}
+}
+
+func BenchmarkFoldMap_Slice(b *testing.B) {
+	benchmarkFoldMapSlice(b, Slice[int]())
+}
+
+func BenchmarkFoldMap_SliceCopying(b *testing.B) {
+	benchmarkFoldMapSlice(b, copying(Slice[int]()))
+}
+
+func benchmarkGroupByMap(b *testing.B, m Monoid[map[int]int]) {
+	input := make([]int, benchSize)
+	for i := range input {
+		input[i] = i
+	}
+	for i := 0; i < b.N; i++ {
+		GroupBy(iterable.New(input), func(v int) int {
+			return v % 2
+		}, func(v int) map[int]int {
+			return map[int]int{v: 1}
+		}, m)
+	}
+}
+
+func BenchmarkGroupBy_Map(b *testing.B) {
+	benchmarkGroupByMap(b, Map[int, int](Sum[int]()))
+}
+
+func BenchmarkGroupBy_MapCopying(b *testing.B) {
+	benchmarkGroupByMap(b, copying(Map[int, int](Sum[int]())))
+}
@@ -0,0 +1,125 @@
+package monoid
+
+import (
+	"github.com/sergeychunayev/gofu/pkg/iterable"
+	"github.com/sergeychunayev/gofu/pkg/option"
+	"github.com/stretchr/testify/require"
+	"strconv"
+	"testing"
+)
+
+func TestMonoids(t *testing.T) {
+	require.Equal(t, 10, Concat(Sum[int](), 1, 2, 3, 4))
+	require.Equal(t, 0, Concat(Sum[int]()))
+	require.Equal(t, 24.0, Concat(Product[float64](), 1, 2, 3, 4))
+	require.Equal(t, 1.0, Concat(Product[float64]()))
+	require.True(t, Concat(All(), true, true))
+	require.False(t, Concat(All(), true, false))
+	require.True(t, Concat(All()))
+	require.True(t, Concat(Any(), false, true))
+	require.False(t, Concat(Any()))
+	require.Equal(t, "abc", Concat(String(), "a", "b", "c"))
+	require.Equal(t, []int{1, 2, 3}, Concat(Slice[int](), []int{1}, nil, []int{2, 3}))
+	require.Nil(t, Concat(Slice[int]()))
+}
+
+func TestSemigroups(t *testing.T) {
+	require.Equal(t, 1, Min[int]().Combine(2, 1))
+	require.Equal(t, "b", Max[string]().Combine("a", "b"))
+	require.Equal(t, 1, First[int]().Combine(1, 2))
+	require.Equal(t, 2, Last[int]().Combine(1, 2))
+}
+
+func TestSlice_DoesNotModify(t *testing.T) {
+	a := make([]int, 1, 10)
+	a[0] = 1
+	res := Slice[int]().Combine(a, []int{2})
+	require.Equal(t, []int{1, 2}, res)
+	require.Equal(t, []int{1, 0}, a[:2])
+}
+
+func TestMap(t *testing.T) {
+	m := Map[string, int](Sum[int]())
+	a := map[string]int{"a": 1, "b": 2}
+	b := map[string]int{"b": 3, "c": 4}
+	require.Equal(t, map[string]int{"a": 1, "b": 5, "c": 4}, m.Combine(a, b))
+	require.Equal(t, map[string]int{"a": 1, "b": 2}, a)
+	require.Equal(t, a, m.Combine(a, m.Empty()))
+	require.Equal(t, a, m.Combine(m.Empty(), a))
+}
+
+func TestOption(t *testing.T) {
+	m := Option(Min[int]())
+	require.Equal(t, option.No[int](), Concat(m))
+	require.Equal(t, option.Of(1), Concat(m, option.Of(3), option.No[int](), option.Of(1)))
+	require.Equal(t, option.Of(3), Concat(m, option.No[int](), option.Of(3)))
+}
+
+func TestFold(t *testing.T) {
+	require.Equal(t, 6, Fold(iterable.New([]int{1, 2, 3}), Sum[int]()))
+	require.Equal(t, 0, Fold(iterable.New([]int{}), Sum[int]()))
+}
+
+func TestFoldMap(t *testing.T) {
+	testCases := []struct {
+		name     string
+		input    []int
+		expected option.Option[int]
+	}{
+		{"Empty", nil, option.No[int]()},
+		{"One", []int{3}, option.Of(3)},
+		{"Many", []int{3, 5, 1, 4}, option.Of(5)},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res := FoldMap(iterable.New(tc.input), option.Of[int], Option(Max[int]()))
+			require.Equal(t, tc.expected, res)
+		})
+	}
+	require.Equal(t, "123", FoldMap(iterable.New([]int{1, 2, 3}), strconv.Itoa, String()))
+}
+
+func TestGroupBy(t *testing.T) {
+	type sale struct {
+		region string
+		amount int
+	}
+	it := iterable.New([]sale{{"eu", 1}, {"us", 2}, {"eu", 3}})
+	region := func(s sale) string {
+		return s.region
+	}
+	amount := func(s sale) int {
+		return s.amount
+	}
+	require.Equal(t, map[string]int{"eu": 4, "us": 2}, GroupBy(it, region, amount, Sum[int]()))
+}
+
+func TestFoldMap_SliceDoesNotAlias(t *testing.T) {
+	shared := make([]int, 1, 10)
+	res := FoldMap(iterable.New([]int{1, 2, 3}), func(v int) []int {
+		shared[0] = v
+		return shared
+	}, Slice[int]())
+	require.Equal(t, []int{1, 2, 3}, res)
+	res[0] = 0
+	require.Equal(t, []int{3}, shared)
+}
+
+func TestGroupBy_Map(t *testing.T) {
+	it := iterable.New([]string{"a", "bb", "cc", "a"})
+	count := func(s string) map[string]int {
+		return map[string]int{s: 1}
+	}
+	res := GroupBy(it, func(s string) int {
+		return len(s)
+	}, count, Map[string, int](Sum[int]()))
+	require.Equal(t, map[int]map[string]int{1: {"a": 2}, 2: {"bb": 1, "cc": 1}}, res)
+}
+
+func TestNew(t *testing.T) {
+	m := New(0, func(a int, b int) int {
+		return a ^ b
+	})
+	require.Equal(t, 0, m.Empty())
+	require.Equal(t, 6, Concat(m, 1, 2, 4, 1))
+}
@@ -0,0 +1,108 @@
+package option
+
+import "github.com/sergeychunayev/gofu/pkg/iterable"
+
+// Map applies f to the value of o, if any.
+func Map[T any, U any](o Option[T], f func(v T) U) Option[U] {
+	if o.IsNone() {
+		return No[U]()
+	}
+	return Of(f(o.Unwrap()))
+}
+
+// FlatMap applies f to the value of o, if any, and returns its result.
+func FlatMap[T any, U any](o Option[T], f func(v T) Option[U]) Option[U] {
+	if o.IsNone() {
+		return No[U]()
+	}
+	return f(o.Unwrap())
+}
+
+// Filter returns o if it holds a value that satisfies f, and None otherwise.
+func Filter[T any](o Option[T], f func(v T) bool) Option[T] {
+	if o.IsSome() && f(o.Unwrap()) {
+		return o
+	}
+	return No[T]()
+}
+
+// OrElse returns o if it holds a value, and alt otherwise.
+func OrElse[T any](o Option[T], alt Option[T]) Option[T] {
+	if o.IsSome() {
+		return o
+	}
+	return alt
+}
+
+// OrElseGet returns o if it holds a value, and the result of f otherwise.
+func OrElseGet[T any](o Option[T], f func() Option[T]) Option[T] {
+	if o.IsSome() {
+		return o
+	}
+	return f()
+}
+
+// UnwrapOrZero returns the value of o or the zero value of T.
+func UnwrapOrZero[T any](o Option[T]) T {
+	var zero T
+	return o.UnwrapOr(zero)
+}
+
+// Expect returns the value of o and panics with msg if there is none.
+func Expect[T any](o Option[T], msg string) T {
+	if o.IsNone() {
+		panic(msg)
+	}
+	return o.Unwrap()
+}
+
+// Match calls onSome with the value of o, or onNone if there is none, and
+// returns the result.
+func Match[T any, R any](o Option[T], onSome func(v T) R, onNone func() R) R {
+	if o.IsNone() {
+		return onNone()
+	}
+	return onSome(o.Unwrap())
+}
+
+// Zip returns both values if both a and b hold one.
+func Zip[A any, B any](a Option[A], b Option[B]) Option[iterable.Tuple[A, B]] {
+	if a.IsNone() || b.IsNone() {
+		return No[iterable.Tuple[A, B]]()
+	}
+	return Of(iterable.Tuple[A, B]{A: a.Unwrap(), B: b.Unwrap()})
+}
+
+// FromPtr returns None for a nil p and the value p points to otherwise.
+func FromPtr[T any](p *T) Option[T] {
+	if p == nil {
+		return No[T]()
+	}
+	return Of(*p)
+}
+
+// ToPtr returns a pointer to a copy of the value of o, or nil.
+func ToPtr[T any](o Option[T]) *T {
+	if o.IsNone() {
+		return nil
+	}
+	v := o.Unwrap()
+	return &v
+}
+
+// FromPair converts the result of a map lookup, a type assertion or Reduce
+// into an Option.
+func FromPair[T any](v T, ok bool) Option[T] {
+	if !ok {
+		return No[T]()
+	}
+	return Of(v)
+}
+
+// ToIterable returns an Iterable over the value of o, if any.
+func ToIterable[T any](o Option[T]) iterable.Iterable[T] {
+	if o.IsNone() {
+		return iterable.New([]T{})
+	}
+	return iterable.New([]T{o.Unwrap()})
+}
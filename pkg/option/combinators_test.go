@@ -0,0 +1,106 @@
+package option
+
+import (
+	"github.com/sergeychunayev/gofu/pkg/iterable"
+	"github.com/stretchr/testify/require"
+	"strconv"
+	"testing"
+)
+
+func TestMap(t *testing.T) {
+	requireOption(t, Of("1"), Map(Of(1), strconv.Itoa))
+	requireOption(t, No[string](), Map(No[int](), strconv.Itoa))
+}
+
+func TestFlatMap(t *testing.T) {
+	parse := func(s string) Option[int] {
+		return FromPair(func() (int, bool) {
+			v, err := strconv.Atoi(s)
+			return v, err == nil
+		}())
+	}
+	requireOption(t, Of(1), FlatMap(Of("1"), parse))
+	requireOption(t, No[int](), FlatMap(Of("x"), parse))
+	requireOption(t, No[int](), FlatMap(No[string](), parse))
+}
+
+func TestFilter(t *testing.T) {
+	requireOption(t, Of(2), Filter(Of(2), isEven))
+	requireOption(t, No[int](), Filter(Of(1), isEven))
+	requireOption(t, No[int](), Filter(No[int](), isEven))
+}
+
+func TestOrElse(t *testing.T) {
+	requireOption(t, Of(1), OrElse(Of(1), Of(2)))
+	requireOption(t, Of(2), OrElse(No[int](), Of(2)))
+	requireOption(t, No[int](), OrElse(No[int](), No[int]()))
+}
+
+func TestOrElseGet(t *testing.T) {
+	calls := 0
+	f := func() Option[int] {
+		calls++
+		return Of(2)
+	}
+	requireOption(t, Of(1), OrElseGet(Of(1), f))
+	require.Zero(t, calls)
+	requireOption(t, Of(2), OrElseGet(No[int](), f))
+	require.Equal(t, 1, calls)
+}
+
+func TestUnwrapOrZero(t *testing.T) {
+	require.Equal(t, 1, UnwrapOrZero(Of(1)))
+	require.Equal(t, 0, UnwrapOrZero(No[int]()))
+	require.Nil(t, UnwrapOrZero(No[*int]()))
+}
+
+func TestExpect(t *testing.T) {
+	require.Equal(t, 1, Expect(Of(1), "must be set"))
+	require.PanicsWithValue(t, "must be set", func() {
+		Expect(No[int](), "must be set")
+	})
+}
+
+func TestMatch(t *testing.T) {
+	onSome := func(v int) string {
+		return "some " + strconv.Itoa(v)
+	}
+	onNone := func() string {
+		return "none"
+	}
+	require.Equal(t, "some 1", Match(Of(1), onSome, onNone))
+	require.Equal(t, "none", Match(No[int](), onSome, onNone))
+}
+
+func TestZip(t *testing.T) {
+	requireOption(t, Of(iterable.Tuple[int, string]{A: 1, B: "a"}), Zip(Of(1), Of("a")))
+	requireOption(t, No[iterable.Tuple[int, string]](), Zip(No[int](), Of("a")))
+	requireOption(t, No[iterable.Tuple[int, string]](), Zip(Of(1), No[string]()))
+}
+
+func TestPtr(t *testing.T) {
+	v := 1
+	requireOption(t, Of(1), FromPtr(&v))
+	requireOption(t, No[int](), FromPtr[int](nil))
+
+	p := ToPtr(Of(1))
+	require.Equal(t, 1, *p)
+	require.Nil(t, ToPtr(No[int]()))
+}
+
+func TestFromPair(t *testing.T) {
+	m := map[string]int{"a": 1}
+	v, ok := m["a"]
+	requireOption(t, Of(1), FromPair(v, ok))
+	v, ok = m["b"]
+	requireOption(t, No[int](), FromPair(v, ok))
+
+	requireOption(t, Of(6), FromPair(iterable.New([]int{1, 2, 3}).Reduce(func(acc int, v int) int {
+		return acc + v
+	})))
+}
+
+func TestToIterable(t *testing.T) {
+	require.Equal(t, []int{1}, ToIterable(Of(1)).ToSlice())
+	require.Empty(t, ToIterable(No[int]()).ToSlice())
+}
@@ -0,0 +1,273 @@
+package option
+
+import (
+	"bytes"
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+)
+
+var null = []byte("null")
+
+// IsZero reports whether o is None. The omitzero option of encoding/json
+// uses it to leave None fields out, but only from Go 1.24 on; with the Go
+// 1.20 toolchain this module targets, a None field is always encoded as null.
+func (o Option[T]) IsZero() bool {
+	return !o.ok
+}
+
+// MarshalJSON encodes None as null and Some as its value.
+func (o Option[T]) MarshalJSON() ([]byte, error) {
+	if !o.ok {
+		return null, nil
+	}
+	return json.Marshal(o.v)
+}
+
+// UnmarshalJSON decodes null as None and any other value as Some.
+func (o *Option[T]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), null) {
+		*o = No[T]()
+		return nil
+	}
+	var v T
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	*o = Of(v)
+	return nil
+}
+
+// MarshalText encodes None as empty text and Some as the text form of its
+// value.
+func (o Option[T]) MarshalText() ([]byte, error) {
+	if !o.ok {
+		return []byte{}, nil
+	}
+	if m, ok := any(o.v).(encoding.TextMarshaler); ok {
+		return m.MarshalText()
+	}
+	rv := reflect.ValueOf(o.v)
+	switch rv.Kind() {
+	case reflect.String:
+		return []byte(rv.String()), nil
+	case reflect.Bool:
+		return strconv.AppendBool(nil, rv.Bool()), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.AppendInt(nil, rv.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return strconv.AppendUint(nil, rv.Uint(), 10), nil
+	case reflect.Float32, reflect.Float64:
+		return strconv.AppendFloat(nil, rv.Float(), 'g', -1, rv.Type().Bits()), nil
+	}
+	return nil, fmt.Errorf("option: cannot marshal %T as text", o.v)
+}
+
+// UnmarshalText decodes empty text as None and anything else as Some.
+func (o *Option[T]) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*o = No[T]()
+		return nil
+	}
+	var v T
+	if err := parseText(string(text), reflect.ValueOf(&v).Elem()); err != nil {
+		return err
+	}
+	*o = Of(v)
+	return nil
+}
+
+// Value implements driver.Valuer. None is stored as NULL.
+func (o Option[T]) Value() (driver.Value, error) {
+	if !o.ok {
+		return nil, nil
+	}
+	return driver.DefaultParameterConverter.ConvertValue(o.v)
+}
+
+// Scan implements sql.Scanner. NULL is read as None.
+func (o *Option[T]) Scan(src any) error {
+	if src == nil {
+		*o = No[T]()
+		return nil
+	}
+	var v T
+	if s, ok := any(&v).(sql.Scanner); ok {
+		if err := s.Scan(src); err != nil {
+			return err
+		}
+		*o = Of(v)
+		return nil
+	}
+
+	dst := reflect.ValueOf(&v).Elem()
+	sv := reflect.ValueOf(src)
+	switch {
+	case sv.Type().AssignableTo(dst.Type()) && sv.Kind() != reflect.Slice:
+		dst.Set(sv)
+	case isNumber(sv.Kind()) && isNumber(dst.Kind()):
+		if err := convertNumber(sv, dst); err != nil {
+			return err
+		}
+	default:
+		var text string
+		switch s := src.(type) {
+		case string:
+			text = s
+		case []byte:
+			text = string(s)
+		default:
+			return fmt.Errorf("option: cannot scan %T into %T", src, v)
+		}
+		if err := parseText(text, dst); err != nil {
+			return err
+		}
+	}
+	*o = Of(v)
+	return nil
+}
+
+func (o Option[T]) String() string {
+	if !o.ok {
+		return "None"
+	}
+	return fmt.Sprintf("Some(%v)", o.v)
+}
+
+func (o Option[T]) GoString() string {
+	if !o.ok {
+		return fmt.Sprintf("option.No[%v]()", reflect.TypeOf((*T)(nil)).Elem())
+	}
+	return fmt.Sprintf("option.Of[%v](%#v)", reflect.TypeOf((*T)(nil)).Elem(), o.v)
+}
+
+func isNumber(k reflect.Kind) bool {
+	switch k {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
+}
+
+// convertNumber stores the number sv in dst, failing instead of silently
+// truncating when the value is out of range for dst or loses its fractional
+// part.
+func convertNumber(sv, dst reflect.Value) error {
+	rangeErr := func() error {
+		return fmt.Errorf("option: converting %v to %v: value out of range", sv, dst.Type())
+	}
+	switch dst.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		var x int64
+		switch {
+		case sv.CanInt():
+			x = sv.Int()
+		case sv.CanUint():
+			if sv.Uint() > math.MaxInt64 {
+				return rangeErr()
+			}
+			x = int64(sv.Uint())
+		default:
+			f := sv.Float()
+			if f != math.Trunc(f) {
+				return fmt.Errorf("option: converting %v to %v: value has a fractional part", sv, dst.Type())
+			}
+			if f < math.MinInt64 || f >= -math.MinInt64 {
+				return rangeErr()
+			}
+			x = int64(f)
+		}
+		if dst.OverflowInt(x) {
+			return rangeErr()
+		}
+		dst.SetInt(x)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		var x uint64
+		switch {
+		case sv.CanInt():
+			if sv.Int() < 0 {
+				return rangeErr()
+			}
+			x = uint64(sv.Int())
+		case sv.CanUint():
+			x = sv.Uint()
+		default:
+			f := sv.Float()
+			if f != math.Trunc(f) {
+				return fmt.Errorf("option: converting %v to %v: value has a fractional part", sv, dst.Type())
+			}
+			if f < 0 || f >= 2*-math.MinInt64 {
+				return rangeErr()
+			}
+			x = uint64(f)
+		}
+		if dst.OverflowUint(x) {
+			return rangeErr()
+		}
+		dst.SetUint(x)
+	default:
+		var f float64
+		switch {
+		case sv.CanInt():
+			f = float64(sv.Int())
+		case sv.CanUint():
+			f = float64(sv.Uint())
+		default:
+			f = sv.Float()
+		}
+		if dst.OverflowFloat(f) {
+			return rangeErr()
+		}
+		dst.SetFloat(f)
+	}
+	return nil
+}
+
+func parseText(s string, dst reflect.Value) error {
+	if u, ok := dst.Addr().Interface().(encoding.TextUnmarshaler); ok {
+		return u.UnmarshalText([]byte(s))
+	}
+	switch dst.Kind() {
+	case reflect.String:
+		dst.SetString(s)
+	case reflect.Slice:
+		if dst.Type().Elem().Kind() != reflect.Uint8 {
+			return fmt.Errorf("option: cannot parse text into %v", dst.Type())
+		}
+		dst.SetBytes([]byte(s))
+	case reflect.Bool:
+		v, err := strconv.ParseBool(s)
+		if err != nil {
+			return err
+		}
+		dst.SetBool(v)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		v, err := strconv.ParseInt(s, 10, dst.Type().Bits())
+		if err != nil {
+			return err
+		}
+		dst.SetInt(v)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		v, err := strconv.ParseUint(s, 10, dst.Type().Bits())
+		if err != nil {
+			return err
+		}
+		dst.SetUint(v)
+	case reflect.Float32, reflect.Float64:
+		v, err := strconv.ParseFloat(s, dst.Type().Bits())
+		if err != nil {
+			return err
+		}
+		dst.SetFloat(v)
+	default:
+		return fmt.Errorf("option: cannot parse text into %v", dst.Type())
+	}
+	return nil
+}
@@ -0,0 +1,208 @@
+package option
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"github.com/stretchr/testify/require"
+	"math"
+	"testing"
+	"time"
+)
+
+type record struct {
+	Name  Option[string]    `json:"name"`
+	Age   Option[int]       `json:"age"`
+	Tags  Option[[]string]  `json:"tags"`
+	Extra Option[time.Time] `json:"extra"`
+}
+
+func TestJSON(t *testing.T) {
+	ts := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+	testCases := []struct {
+		name    string
+		input   record
+		encoded string
+	}{
+		{
+			"Some",
+			record{Of("bob"), Of(30), Of([]string{"a"}), Of(ts)},
+			`{"name":"bob","age":30,"tags":["a"],"extra":"2020-01-02T03:04:05Z"}`,
+		},
+		{
+			"None",
+			record{},
+			`{"name":null,"age":null,"tags":null,"extra":null}`,
+		},
+		{
+			"Zero values",
+			record{Of(""), Of(0), No[[]string](), No[time.Time]()},
+			`{"name":"","age":0,"tags":null,"extra":null}`,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			b, err := json.Marshal(tc.input)
+			require.NoError(t, err)
+			require.JSONEq(t, tc.encoded, string(b))
+
+			var res record
+			require.NoError(t, json.Unmarshal(b, &res))
+			require.Equal(t, tc.input, res)
+		})
+	}
+}
+
+func TestJSON_Omitted(t *testing.T) {
+	var res record
+	require.NoError(t, json.Unmarshal([]byte(`{"age":1}`), &res))
+	require.Equal(t, record{Age: Of(1)}, res)
+}
+
+func TestJSON_Invalid(t *testing.T) {
+	var res record
+	require.Error(t, json.Unmarshal([]byte(`{"age":"x"}`), &res))
+}
+
+func TestText(t *testing.T) {
+	testCases := []struct {
+		name  string
+		check func(t *testing.T)
+	}{
+		{"int", roundTripText(Of(-1), "-1")},
+		{"uint", roundTripText(Of[uint8](255), "255")},
+		{"float", roundTripText(Of(1.5), "1.5")},
+		{"bool", roundTripText(Of(true), "true")},
+		{"string", roundTripText(Of("a b"), "a b")},
+		{"time", roundTripText(Of(time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)), "2020-01-02T03:04:05Z")},
+		{"None", roundTripText(No[int](), "")},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, tc.check)
+	}
+}
+
+func roundTripText[T any](o Option[T], text string) func(t *testing.T) {
+	return func(t *testing.T) {
+		b, err := o.MarshalText()
+		require.NoError(t, err)
+		require.Equal(t, text, string(b))
+
+		var res Option[T]
+		require.NoError(t, res.UnmarshalText(b))
+		require.Equal(t, o, res)
+	}
+}
+
+func TestText_Invalid(t *testing.T) {
+	var res Option[int]
+	require.Error(t, res.UnmarshalText([]byte("x")))
+
+	_, err := Of(struct{}{}).MarshalText()
+	require.Error(t, err)
+}
+
+func TestSQL_Value(t *testing.T) {
+	testCases := []struct {
+		name     string
+		input    driver.Valuer
+		expected driver.Value
+	}{
+		{"None", No[int](), nil},
+		{"int", Of(1), int64(1)},
+		{"uint8", Of[uint8](1), int64(1)},
+		{"string", Of("a"), "a"},
+		{"bytes", Of([]byte("a")), []byte("a")},
+		{"float", Of(1.5), 1.5},
+		{"Valuer", Of(sql.NullInt64{Int64: 1, Valid: true}), int64(1)},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := tc.input.Value()
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, res)
+		})
+	}
+}
+
+func TestSQL_Scan(t *testing.T) {
+	ts := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+	testCases := []struct {
+		name  string
+		check func(t *testing.T)
+	}{
+		{"NULL", scan(nil, No[int]())},
+		{"int64 to int", scan(int64(1), Of(1))},
+		{"int64 to float", scan(int64(1), Of(1.0))},
+		{"float to float32", scan(1.5, Of[float32](1.5))},
+		{"whole float to int", scan(2.0, Of(2))},
+		{"int64 to uint8", scan(int64(255), Of[uint8](255))},
+		{"bytes to string", scan([]byte("a"), Of("a"))},
+		{"bytes to int", scan([]byte("12"), Of(12))},
+		{"string to bool", scan("true", Of(true))},
+		{"bytes to bytes", scan([]byte("a"), Of([]byte("a")))},
+		{"time", scan(ts, Of(ts))},
+		{"Scanner", scan("a", Of(sql.NullString{String: "a", Valid: true}))},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, tc.check)
+	}
+}
+
+func scan[T any](src any, expected Option[T]) func(t *testing.T) {
+	return func(t *testing.T) {
+		res := Of(*new(T))
+		require.NoError(t, res.Scan(src))
+		require.Equal(t, expected, res)
+	}
+}
+
+func TestSQL_ScanCopiesBytes(t *testing.T) {
+	src := []byte("a")
+	var res Option[[]byte]
+	require.NoError(t, res.Scan(src))
+	src[0] = 'b'
+	require.Equal(t, []byte("a"), res.Unwrap())
+}
+
+func TestSQL_ScanInvalid(t *testing.T) {
+	var res Option[int]
+	require.Error(t, res.Scan("x"))
+	require.Error(t, res.Scan(time.Time{}))
+}
+
+func TestSQL_ScanOutOfRange(t *testing.T) {
+	testCases := []struct {
+		name  string
+		check func(t *testing.T)
+	}{
+		{"int8 overflow", scanErr[int8](int64(300))},
+		{"int8 underflow", scanErr[int8](int64(-129))},
+		{"negative to uint", scanErr[uint](int64(-1))},
+		{"uint8 overflow", scanErr[uint8](int64(256))},
+		{"fractional float to int", scanErr[int](1.5)},
+		{"large float to int64", scanErr[int64](1e19)},
+		{"NaN to int", scanErr[int](math.NaN())},
+		{"float32 overflow", scanErr[float32](1e39)},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, tc.check)
+	}
+}
+
+func scanErr[T any](src any) func(t *testing.T) {
+	return func(t *testing.T) {
+		var res Option[T]
+		require.Error(t, res.Scan(src))
+		require.True(t, res.IsNone())
+	}
+}
+
+func TestString(t *testing.T) {
+	require.Equal(t, "Some(1)", Of(1).String())
+	require.Equal(t, "None", No[int]().String())
+	require.Equal(t, "Some(a)", fmt.Sprint(Of("a")))
+	require.Equal(t, `option.Of[string]("a")`, fmt.Sprintf("%#v", Of("a")))
+	require.Equal(t, "option.No[int]()", fmt.Sprintf("%#v", No[int]()))
+}
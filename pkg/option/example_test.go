@@ -0,0 +1,52 @@
+package option_test
+
+import (
+	"fmt"
+	"github.com/sergeychunayev/gofu/pkg/iterable"
+	"github.com/sergeychunayev/gofu/pkg/option"
+)
+
+func ExampleFind() {
+	type S struct {
+		name  string
+		value int
+	}
+	res := option.Find(iterable.New([]S{
+		{"one", 1},
+		{"two", 2},
+		{"three", 3},
+	}), func(v S) bool {
+		return v.value > 1
+	})
+	fmt.Println(res.Unwrap())
+	// Output: {two 2}
+}
+
+func ExampleFirst() {
+	res := option.First(iterable.New([]int{}))
+	fmt.Println(res.IsNone(), res.UnwrapOr(-1))
+	// Output: true -1
+}
+
+func ExampleMap() {
+	ages := map[string]int{"bob": 30}
+	describe := func(name string) string {
+		age, ok := ages[name]
+		return option.Match(
+			option.Map(option.FromPair(age, ok), func(v int) string {
+				return fmt.Sprintf("%s is %d", name, v)
+			}),
+			func(v string) string {
+				return v
+			},
+			func() string {
+				return name + " is unknown"
+			},
+		)
+	}
+	fmt.Println(describe("bob"))
+	fmt.Println(describe("alice"))
+	// Output:
+	// bob is 30
+	// alice is unknown
+}
@@ -0,0 +1,77 @@
+package option
+
+import "github.com/sergeychunayev/gofu/pkg/iterable"
+
+// First returns the first element of it.
+func First[T any](it iterable.Iterable[T]) Option[T] {
+	return FromPair(it.TryNext())
+}
+
+// Nth returns the element of it at index n, counting from 0.
+func Nth[T any](it iterable.Iterable[T], n int) Option[T] {
+	if n < 0 {
+		return No[T]()
+	}
+	i := 0
+	for v, ok := it.TryNext(); ok; v, ok = it.TryNext() {
+		if i == n {
+			return Of(v)
+		}
+		i++
+	}
+	return No[T]()
+}
+
+// Find returns the first element of it that satisfies f.
+func Find[T any](it iterable.Iterable[T], f func(v T) bool) Option[T] {
+	for v, ok := it.TryNext(); ok; v, ok = it.TryNext() {
+		if f(v) {
+			return Of(v)
+		}
+	}
+	return No[T]()
+}
+
+// FindIndex returns the index of the first element of it that satisfies f.
+func FindIndex[T any](it iterable.Iterable[T], f func(v T) bool) Option[int] {
+	i := 0
+	for v, ok := it.TryNext(); ok; v, ok = it.TryNext() {
+		if f(v) {
+			return Of(i)
+		}
+		i++
+	}
+	return No[int]()
+}
+
+// FindLast returns the last element of it that satisfies f. Double-ended
+// iterables are searched from the back.
+func FindLast[T any](it iterable.Iterable[T], f func(v T) bool) Option[T] {
+	if de, ok := it.(iterable.DoubleEnded[T]); ok {
+		for de.HasNext() {
+			v := de.NextBack()
+			if f(v) {
+				return Of(v)
+			}
+		}
+		return No[T]()
+	}
+
+	res := No[T]()
+	for v, ok := it.TryNext(); ok; v, ok = it.TryNext() {
+		if f(v) {
+			res = Of(v)
+		}
+	}
+	return res
+}
+
+// Single returns the only element of it, or None if it is empty or has more
+// than one element.
+func Single[T any](it iterable.Iterable[T]) Option[T] {
+	res, ok := it.TryNext()
+	if !ok || it.HasNext() {
+		return No[T]()
+	}
+	return Of(res)
+}
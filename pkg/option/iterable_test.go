@@ -0,0 +1,104 @@
+package option
+
+import (
+	"github.com/sergeychunayev/gofu/pkg/iterable"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func isEven(v int) bool {
+	return v%2 == 0
+}
+
+// upTo returns a single-ended Iterable over 1..n.
+func upTo(n int) iterable.Iterable[int] {
+	i := 0
+	return iterable.FromFunc(func() (int, bool) {
+		i++
+		return i, i <= n
+	})
+}
+
+func requireOption[T any](t *testing.T, expected Option[T], actual Option[T]) {
+	require.Equal(t, expected.IsSome(), actual.IsSome())
+	if expected.IsSome() {
+		require.Equal(t, expected.Unwrap(), actual.Unwrap())
+	}
+}
+
+func TestFirst(t *testing.T) {
+	requireOption(t, No[int](), First(iterable.New([]int{})))
+	requireOption(t, Of(1), First(iterable.New([]int{1, 2})))
+	requireOption(t, Of(2), First(iterable.New([]int{1, 2, 3}).Filter(isEven)))
+}
+
+func TestNth(t *testing.T) {
+	testCases := []struct {
+		name     string
+		n        int
+		expected Option[int]
+	}{
+		{"Negative", -1, No[int]()},
+		{"First", 0, Of(1)},
+		{"Middle", 1, Of(2)},
+		{"Last", 2, Of(3)},
+		{"Out of range", 3, No[int]()},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			requireOption(t, tc.expected, Nth(iterable.New([]int{1, 2, 3}), tc.n))
+		})
+	}
+}
+
+func TestFind(t *testing.T) {
+	requireOption(t, No[int](), Find(iterable.New([]int{}), isEven))
+	requireOption(t, No[int](), Find(iterable.New([]int{1, 3}), isEven))
+	requireOption(t, Of(2), Find(iterable.New([]int{1, 2, 3, 4}), isEven))
+
+	itr := iterable.New([]int{1, 2, 3, 4})
+	Find(itr, isEven)
+	require.Equal(t, 3, itr.Next())
+}
+
+func TestFindIndex(t *testing.T) {
+	requireOption(t, No[int](), FindIndex(iterable.New([]int{}), isEven))
+	requireOption(t, No[int](), FindIndex(iterable.New([]int{1, 3}), isEven))
+	requireOption(t, Of(1), FindIndex(iterable.New([]int{1, 2, 3, 4}), isEven))
+}
+
+func TestFindLast(t *testing.T) {
+	testCases := []struct {
+		name     string
+		input    iterable.Iterable[int]
+		expected Option[int]
+	}{
+		{"Empty", iterable.New([]int{}), No[int]()},
+		{"None", iterable.New([]int{1, 3}), No[int]()},
+		{"Slice", iterable.New([]int{1, 2, 3, 4, 5}), Of(4)},
+		{"Func", upTo(5), Of(4)},
+		{"Func none", upTo(1), No[int]()},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			requireOption(t, tc.expected, FindLast(tc.input, isEven))
+		})
+	}
+}
+
+func TestFindLast_FromBack(t *testing.T) {
+	calls := 0
+	res := FindLast(iterable.New([]int{1, 2, 3, 4, 5}), func(v int) bool {
+		calls++
+		return isEven(v)
+	})
+	requireOption(t, Of(4), res)
+	require.Equal(t, 2, calls)
+}
+
+func TestSingle(t *testing.T) {
+	requireOption(t, No[int](), Single(iterable.New([]int{})))
+	requireOption(t, Of(1), Single(iterable.New([]int{1})))
+	requireOption(t, No[int](), Single(iterable.New([]int{1, 2})))
+	requireOption(t, Of(2), Single(iterable.New([]int{1, 2, 3}).Filter(isEven)))
+}
@@ -1,48 +1,39 @@
 package option
 
-type Option[T any] interface {
-	IsNone() bool
-	IsSome() bool
-	Unwrap() T
-	UnwrapOr(def T) T
+// Option holds either a value (Some) or nothing (None). The zero value is
+// None, Options are plain values that never allocate, and they can be
+// compared with == whenever T is comparable.
+type Option[T any] struct {
+	v  T
+	ok bool
 }
 
-type None[T any] struct{}
-
-func (*None[T]) IsNone() bool {
-	return true
-}
-func (*None[T]) IsSome() bool {
-	return false
-}
-func (*None[T]) Unwrap() T {
-	panic("None")
-}
-func (*None[T]) UnwrapOr(def T) T {
-	return def
+func (o Option[T]) IsNone() bool {
+	return !o.ok
 }
 
-type Some[T any] struct {
-	v T
+func (o Option[T]) IsSome() bool {
+	return o.ok
 }
 
-func (*Some[T]) IsNone() bool {
-	return false
+func (o Option[T]) Unwrap() T {
+	if !o.ok {
+		panic("None")
+	}
+	return o.v
 }
-func (*Some[T]) IsSome() bool {
-	return true
-}
-func (v *Some[T]) Unwrap() T {
-	return v.v
-}
-func (v *Some[T]) UnwrapOr(T) T {
-	return v.v
+
+func (o Option[T]) UnwrapOr(def T) T {
+	if !o.ok {
+		return def
+	}
+	return o.v
 }
 
 func No[T any]() Option[T] {
-	return &None[T]{}
+	return Option[T]{}
 }
 
 func Of[T any](v T) Option[T] {
-	return &Some[T]{v}
+	return Option[T]{v, true}
 }
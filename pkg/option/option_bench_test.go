@@ -0,0 +1,86 @@
+package option
+
+import "testing"
+
+// ifaceOption reproduces the former interface-based design, where every
+// value was a pointer to None or Some behind an interface, for comparison.
+type ifaceOption[T any] interface {
+	IsNone() bool
+	UnwrapOr(def T) T
+}
+
+type ifaceNone[T any] struct{}
+
+func (*ifaceNone[T]) IsNone() bool {
+	return true
+}
+
+func (*ifaceNone[T]) UnwrapOr(def T) T {
+	return def
+}
+
+type ifaceSome[T any] struct {
+	v T
+}
+
+func (*ifaceSome[T]) IsNone() bool {
+	return false
+}
+
+func (v *ifaceSome[T]) UnwrapOr(T) T {
+	return v.v
+}
+
+//go:noinline
+func ifaceOf(v int) ifaceOption[int] {
+	if v%2 == 0 {
+		return &ifaceNone[int]{}
+	}
+	return &ifaceSome[int]{v}
+}
+
+//go:noinline
+func structOf(v int) Option[int] {
+	if v%2 == 0 {
+		return No[int]()
+	}
+	return Of(v)
+}
+
+func BenchmarkInterfaceOption(b *testing.B) {
+	b.ReportAllocs()
+	res := 0
+	for i := 0; i < b.N; i++ {
+		res += ifaceOf(i).UnwrapOr(1)
+	}
+	_ = res
+}
+
+func BenchmarkOption(b *testing.B) {
+	b.ReportAllocs()
+	res := 0
+	for i := 0; i < b.N; i++ {
+		res += structOf(i).UnwrapOr(1)
+	}
+	_ = res
+}
+
+func BenchmarkInterfaceOption_Slice(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		arr := make([]ifaceOption[int], 1000)
+		for j := range arr {
+			arr[j] = ifaceOf(j)
+		}
+	}
+}
+
+func BenchmarkOption_Slice(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		arr := make([]Option[int], 1000)
+		for j := range arr {
+			arr[j] = structOf(j)
+		}
+	}
+}
@@ -53,3 +53,40 @@ func TestOption(t *testing.T) {
 		})
 	}
 }
+
+func TestOption_ZeroValue(t *testing.T) {
+	var res Option[int]
+	require.True(t, res.IsNone())
+	require.False(t, res.IsSome())
+	require.Equal(t, 2, res.UnwrapOr(2))
+
+	type s struct {
+		v Option[string]
+	}
+	require.True(t, s{}.v.IsNone())
+}
+
+func TestOption_Comparable(t *testing.T) {
+	require.True(t, Of(1) == Of(1))
+	require.False(t, Of(1) == Of(2))
+	require.False(t, Of(0) == No[int]())
+	require.True(t, No[int]() == Option[int]{})
+
+	set := map[Option[string]]bool{Of("a"): true, No[string](): true}
+	require.True(t, set[Of("a")])
+	require.True(t, set[Option[string]{}])
+	require.False(t, set[Of("b")])
+}
+
+var sink Option[int]
+
+func TestOption_NoAllocs(t *testing.T) {
+	allocs := testing.AllocsPerRun(100, func() {
+		sink = Of(1)
+		sink = Map(sink, func(v int) int {
+			return v + 1
+		})
+		sink = OrElse(No[int](), sink)
+	})
+	require.Zero(t, allocs)
+}
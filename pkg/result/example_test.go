@@ -0,0 +1,28 @@
+package result_test
+
+import (
+	"fmt"
+	"github.com/sergeychunayev/gofu/pkg/iterable"
+	"github.com/sergeychunayev/gofu/pkg/result"
+	"strconv"
+)
+
+func ExampleCollect() {
+	res, err := result.Collect(iterable.Map(iterable.New([]string{"1", "2", "3"}), result.Try(strconv.Atoi)))
+	fmt.Println(res, err)
+	// Output: [1 2 3] <nil>
+}
+
+func ExamplePartition() {
+	oks, errs := result.Partition(iterable.Map(iterable.New([]string{"1", "x", "3"}), result.Try(strconv.Atoi)))
+	fmt.Println(oks, len(errs))
+	// Output: [1 3] 1
+}
+
+func ExampleMap() {
+	r := result.Map(result.From(strconv.Atoi("21")), func(v int) int {
+		return v * 2
+	})
+	fmt.Println(r)
+	// Output: Ok(42)
+}
@@ -0,0 +1,33 @@
+package result
+
+import "github.com/sergeychunayev/gofu/pkg/iterable"
+
+// Collect returns the values of it, or the first error it yields. Iteration
+// stops at the first error.
+func Collect[T any](it iterable.Iterable[Result[T]]) ([]T, error) {
+	var res []T
+	if n, ok := iterable.Len(it); ok && n > 0 {
+		res = make([]T, 0, n)
+	}
+	for r, ok := it.TryNext(); ok; r, ok = it.TryNext() {
+		if r.err != nil {
+			return nil, r.err
+		}
+		res = append(res, r.v)
+	}
+	return res, nil
+}
+
+// Partition splits the elements of it into values and errors.
+func Partition[T any](it iterable.Iterable[Result[T]]) ([]T, []error) {
+	var oks []T
+	var errs []error
+	for r, ok := it.TryNext(); ok; r, ok = it.TryNext() {
+		if r.err != nil {
+			errs = append(errs, r.err)
+			continue
+		}
+		oks = append(oks, r.v)
+	}
+	return oks, errs
+}
@@ -0,0 +1,139 @@
+package result
+
+import (
+	"errors"
+	"fmt"
+	"github.com/sergeychunayev/gofu/pkg/option"
+)
+
+// Result holds either a value (Ok) or an error (Err). The zero value is Ok
+// with the zero value of T.
+type Result[T any] struct {
+	v   T
+	err error
+}
+
+func (r Result[T]) IsOk() bool {
+	return r.err == nil
+}
+
+func (r Result[T]) IsErr() bool {
+	return r.err != nil
+}
+
+// Err returns the error of r, or nil if r is Ok.
+func (r Result[T]) Err() error {
+	return r.err
+}
+
+// Get returns the value and the error of r in the usual Go form.
+func (r Result[T]) Get() (T, error) {
+	return r.v, r.err
+}
+
+// Unwrap returns the value of r and panics with its error if r is Err.
+func (r Result[T]) Unwrap() T {
+	if r.err != nil {
+		panic(r.err)
+	}
+	return r.v
+}
+
+func (r Result[T]) UnwrapOr(def T) T {
+	if r.err != nil {
+		return def
+	}
+	return r.v
+}
+
+func (r Result[T]) String() string {
+	if r.err != nil {
+		return fmt.Sprintf("Err(%v)", r.err)
+	}
+	return fmt.Sprintf("Ok(%v)", r.v)
+}
+
+func Ok[T any](v T) Result[T] {
+	return Result[T]{v: v}
+}
+
+// Err returns a failed Result. A nil err is treated as an unknown error
+// so that the Result is never mistaken for Ok.
+func Err[T any](err error) Result[T] {
+	if err == nil {
+		err = errUnknown
+	}
+	return Result[T]{err: err}
+}
+
+var errUnknown = errors.New("result: nil error")
+
+// From converts a (value, error) pair into a Result.
+func From[T any](v T, err error) Result[T] {
+	if err != nil {
+		return Result[T]{err: err}
+	}
+	return Ok(v)
+}
+
+// Try adapts f for use with iterable.Map.
+func Try[T any, U any](f func(v T) (U, error)) func(v T) Result[U] {
+	return func(v T) Result[U] {
+		return From(f(v))
+	}
+}
+
+// Expect returns the value of r and panics with msg and the error if r is
+// Err.
+func Expect[T any](r Result[T], msg string) T {
+	if r.err != nil {
+		panic(fmt.Sprintf("%s: %v", msg, r.err))
+	}
+	return r.v
+}
+
+// UnwrapOrZero returns the value of r or the zero value of T.
+func UnwrapOrZero[T any](r Result[T]) T {
+	var zero T
+	return r.UnwrapOr(zero)
+}
+
+// Map applies f to the value of r if r is Ok.
+func Map[T any, U any](r Result[T], f func(v T) U) Result[U] {
+	if r.err != nil {
+		return Result[U]{err: r.err}
+	}
+	return Ok(f(r.v))
+}
+
+// FlatMap applies f to the value of r if r is Ok and returns its result.
+func FlatMap[T any, U any](r Result[T], f func(v T) Result[U]) Result[U] {
+	if r.err != nil {
+		return Result[U]{err: r.err}
+	}
+	return f(r.v)
+}
+
+// MapErr applies f to the error of r if r is Err.
+func MapErr[T any](r Result[T], f func(err error) error) Result[T] {
+	if r.err == nil {
+		return r
+	}
+	return Err[T](f(r.err))
+}
+
+// ToOption returns the value of r as Some, dropping the error if r is Err.
+func ToOption[T any](r Result[T]) option.Option[T] {
+	if r.err != nil {
+		return option.No[T]()
+	}
+	return option.Of(r.v)
+}
+
+// ErrOption returns the error of r as Some if r is Err.
+func ErrOption[T any](r Result[T]) option.Option[error] {
+	if r.err == nil {
+		return option.No[error]()
+	}
+	return option.Of(r.err)
+}
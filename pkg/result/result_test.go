@@ -0,0 +1,117 @@
+package result
+
+import (
+	"errors"
+	"fmt"
+	"github.com/sergeychunayev/gofu/pkg/iterable"
+	"github.com/sergeychunayev/gofu/pkg/option"
+	"github.com/stretchr/testify/require"
+	"strconv"
+	"testing"
+)
+
+var errTest = errors.New("test")
+
+func TestResult(t *testing.T) {
+	ok := Ok(1)
+	require.True(t, ok.IsOk())
+	require.False(t, ok.IsErr())
+	require.NoError(t, ok.Err())
+	require.Equal(t, 1, ok.Unwrap())
+	require.Equal(t, 1, ok.UnwrapOr(2))
+	require.Equal(t, "Ok(1)", ok.String())
+
+	err := Err[int](errTest)
+	require.False(t, err.IsOk())
+	require.True(t, err.IsErr())
+	require.ErrorIs(t, err.Err(), errTest)
+	require.Equal(t, 2, err.UnwrapOr(2))
+	require.Zero(t, UnwrapOrZero(err))
+	require.Equal(t, "Err(test)", err.String())
+	require.PanicsWithError(t, "test", func() {
+		err.Unwrap()
+	})
+	require.PanicsWithValue(t, "parse: test", func() {
+		Expect(err, "parse")
+	})
+}
+
+func TestErr_Nil(t *testing.T) {
+	require.True(t, Err[int](nil).IsErr())
+}
+
+func TestFrom(t *testing.T) {
+	v, err := From(strconv.Atoi("12")).Get()
+	require.NoError(t, err)
+	require.Equal(t, 12, v)
+
+	r := From(strconv.Atoi("x"))
+	require.True(t, r.IsErr())
+	require.Error(t, r.Err())
+}
+
+func TestMap(t *testing.T) {
+	require.Equal(t, Ok("2"), Map(Ok(2), strconv.Itoa))
+	require.ErrorIs(t, Map(Err[int](errTest), strconv.Itoa).Err(), errTest)
+}
+
+func TestFlatMap(t *testing.T) {
+	parse := func(s string) Result[int] {
+		return From(strconv.Atoi(s))
+	}
+	require.Equal(t, Ok(3), FlatMap(Ok("3"), parse))
+	require.True(t, FlatMap(Ok("x"), parse).IsErr())
+	require.ErrorIs(t, FlatMap(Err[string](errTest), parse).Err(), errTest)
+}
+
+func TestMapErr(t *testing.T) {
+	wrap := func(err error) error {
+		return fmt.Errorf("wrapped: %w", err)
+	}
+	r := MapErr(Err[int](errTest), wrap)
+	require.ErrorIs(t, r.Err(), errTest)
+	require.EqualError(t, r.Err(), "wrapped: test")
+	require.Equal(t, Ok(1), MapErr(Ok(1), wrap))
+}
+
+func TestToOption(t *testing.T) {
+	require.Equal(t, option.Of(1), ToOption(Ok(1)))
+	require.Equal(t, option.No[int](), ToOption(Err[int](errTest)))
+	require.Equal(t, option.Of(errTest), ErrOption(Err[int](errTest)))
+	require.Equal(t, option.No[error](), ErrOption(Ok(1)))
+}
+
+func TestCollect(t *testing.T) {
+	testCases := []struct {
+		name     string
+		input    []string
+		expected []int
+		err      bool
+	}{
+		{"Empty", nil, nil, false},
+		{"All ok", []string{"1", "2", "3"}, []int{1, 2, 3}, false},
+		{"Error", []string{"1", "x", "3"}, nil, true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			it := iterable.Map(iterable.New(tc.input), Try(strconv.Atoi))
+			res, err := Collect(it)
+			require.Equal(t, tc.err, err != nil)
+			require.Equal(t, tc.expected, res)
+		})
+	}
+}
+
+func TestCollect_StopsAtError(t *testing.T) {
+	it := iterable.New([]Result[int]{Ok(1), Err[int](errTest), Ok(3)})
+	_, err := Collect[int](it)
+	require.ErrorIs(t, err, errTest)
+	require.Equal(t, []Result[int]{Ok(3)}, it.ToSlice())
+}
+
+func TestPartition(t *testing.T) {
+	it := iterable.New([]Result[int]{Ok(1), Err[int](errTest), Ok(3)})
+	oks, errs := Partition[int](it)
+	require.Equal(t, []int{1, 3}, oks)
+	require.Equal(t, []error{errTest}, errs)
+}
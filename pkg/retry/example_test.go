@@ -0,0 +1,24 @@
+package retry_test
+
+import (
+	"errors"
+	"fmt"
+	"github.com/sergeychunayev/gofu/pkg/iterable"
+	"github.com/sergeychunayev/gofu/pkg/retry"
+	"time"
+)
+
+func ExampleOrElse() {
+	calls := map[int]int{}
+	fetch := func(id int) (string, error) {
+		calls[id]++
+		if calls[id] < 2 || id < 0 {
+			return "", errors.New("unavailable")
+		}
+		return fmt.Sprintf("item %d", id), nil
+	}
+	f := retry.OrElse(retry.Func(fetch, retry.Attempts(3), retry.Backoff(time.Millisecond, time.Millisecond, 1)), "n/a")
+	res := iterable.Map(iterable.New([]int{1, -1, 2}), f).ToSlice()
+	fmt.Println(res)
+	// Output: [item 1 n/a item 2]
+}
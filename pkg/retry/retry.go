@@ -0,0 +1,205 @@
+package retry
+
+import (
+	"context"
+	"errors"
+	"github.com/sergeychunayev/gofu/pkg/clock"
+	"math"
+	"math/rand"
+	"time"
+)
+
+// ErrTimeout is returned when an attempt takes longer than the per-attempt
+// timeout.
+var ErrTimeout = errors.New("retry: attempt timed out")
+
+// Option configures retries.
+type Option func(c *config)
+
+type config struct {
+	attempts   int
+	initial    time.Duration
+	max        time.Duration
+	multiplier float64
+	jitter     float64
+	retryable  func(err error) bool
+	timeout    time.Duration
+	clock      clock.Clock
+	rand       func() float64
+}
+
+// Attempts sets the maximum number of attempts, including the first one.
+// Defaults to 3. Panics if n < 1.
+func Attempts(n int) Option {
+	if n < 1 {
+		panic("retry: non-positive number of attempts")
+	}
+	return func(c *config) {
+		c.attempts = n
+	}
+}
+
+// Backoff sets the delay before the first retry, the maximum delay and the
+// factor the delay grows by after every retry. Defaults to 100ms, 10s and 2.
+func Backoff(initial time.Duration, maxDelay time.Duration, multiplier float64) Option {
+	return func(c *config) {
+		c.initial = initial
+		c.max = maxDelay
+		c.multiplier = multiplier
+	}
+}
+
+// Jitter randomizes every delay by up to the given fraction in either
+// direction, e.g. 0.2 for ±20%.
+func Jitter(fraction float64) Option {
+	return func(c *config) {
+		c.jitter = fraction
+	}
+}
+
+// If retries only errors for which f returns true. By default every error
+// is retried.
+func If(f func(err error) bool) Option {
+	return func(c *config) {
+		c.retryable = f
+	}
+}
+
+// Timeout bounds the duration of every attempt. An attempt that times out
+// fails with ErrTimeout and may be retried.
+func Timeout(d time.Duration) Option {
+	return func(c *config) {
+		c.timeout = d
+	}
+}
+
+// WithClock makes retries measure time with clk instead of the real clock.
+func WithClock(clk clock.Clock) Option {
+	return func(c *config) {
+		c.clock = clk
+	}
+}
+
+// WithRand sets the source of randomness used for jitter. f must return
+// values in [0, 1).
+func WithRand(f func() float64) Option {
+	return func(c *config) {
+		c.rand = f
+	}
+}
+
+func newConfig(opts []Option) *config {
+	res := &config{
+		attempts:   3,
+		initial:    100 * time.Millisecond,
+		max:        10 * time.Second,
+		multiplier: 2,
+		clock:      clock.Real(),
+		rand:       rand.Float64,
+	}
+	for _, o := range opts {
+		o(res)
+	}
+	return res
+}
+
+// delay returns the backoff before retry number n, starting from 0.
+func (c *config) delay(n int) time.Duration {
+	d := float64(c.initial) * math.Pow(c.multiplier, float64(n))
+	if d > float64(c.max) {
+		d = float64(c.max)
+	}
+	if c.jitter > 0 {
+		d *= 1 + c.jitter*(2*c.rand()-1)
+	}
+	return time.Duration(d)
+}
+
+// Func wraps f so that failed calls are retried according to opts. The
+// returned function can be passed to iterable.Map through OrElse.
+func Func[T any, U any](f func(v T) (U, error), opts ...Option) func(v T) (U, error) {
+	g := Context(func(_ context.Context, v T) (U, error) {
+		return f(v)
+	}, opts...)
+	return func(v T) (U, error) {
+		return g(context.Background(), v)
+	}
+}
+
+// Context wraps f so that failed calls are retried according to opts. The
+// context passed to f is cancelled once the attempt times out, and no more
+// attempts are made once ctx is done.
+func Context[T any, U any](f func(ctx context.Context, v T) (U, error), opts ...Option) func(ctx context.Context, v T) (U, error) {
+	cfg := newConfig(opts)
+	return func(ctx context.Context, v T) (U, error) {
+		var res U
+		var err error
+		for n := 0; n < cfg.attempts; n++ {
+			if n > 0 {
+				if e := cfg.sleep(ctx, cfg.delay(n-1)); e != nil {
+					return res, e
+				}
+			}
+			res, err = attempt(ctx, cfg, f, v)
+			if err == nil || (cfg.retryable != nil && !cfg.retryable(err)) {
+				return res, err
+			}
+		}
+		return res, err
+	}
+}
+
+func (c *config) sleep(ctx context.Context, d time.Duration) error {
+	timer := c.clock.NewTimer(d)
+	select {
+	case <-timer.C():
+		return nil
+	case <-ctx.Done():
+		timer.Stop()
+		return ctx.Err()
+	}
+}
+
+type outcome[U any] struct {
+	v   U
+	err error
+}
+
+func attempt[T any, U any](ctx context.Context, c *config, f func(ctx context.Context, v T) (U, error), v T) (U, error) {
+	if c.timeout <= 0 {
+		return f(ctx, v)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	done := make(chan outcome[U], 1)
+	go func() {
+		res, err := f(ctx, v)
+		done <- outcome[U]{res, err}
+	}()
+
+	timer := c.clock.NewTimer(c.timeout)
+	defer timer.Stop()
+	select {
+	case o := <-done:
+		return o.v, o.err
+	case <-timer.C():
+		var res U
+		return res, ErrTimeout
+	case <-ctx.Done():
+		var res U
+		return res, ctx.Err()
+	}
+}
+
+// OrElse adapts f for use with iterable.Map by returning fallback whenever f
+// fails.
+func OrElse[T any, U any](f func(v T) (U, error), fallback U) func(v T) U {
+	return func(v T) U {
+		res, err := f(v)
+		if err != nil {
+			return fallback
+		}
+		return res
+	}
+}
@@ -0,0 +1,219 @@
+package retry
+
+import (
+	"context"
+	"errors"
+	"github.com/sergeychunayev/gofu/pkg/clock"
+	"github.com/sergeychunayev/gofu/pkg/iterable"
+	"github.com/stretchr/testify/require"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+var errFlaky = errors.New("flaky")
+
+// flaky returns a function that fails the given number of times before
+// doubling its input.
+func flaky(failures int) (func(v int) (int, error), *int) {
+	calls := 0
+	return func(v int) (int, error) {
+		calls++
+		if calls <= failures {
+			return 0, errFlaky
+		}
+		return v * 2, nil
+	}, &calls
+}
+
+type result struct {
+	v   int
+	err error
+}
+
+func call(f func(v int) (int, error), v int) <-chan result {
+	res := make(chan result, 1)
+	go func() {
+		r, err := f(v)
+		res <- result{r, err}
+	}()
+	return res
+}
+
+func TestFunc(t *testing.T) {
+	clk := clock.NewFake(time.Time{})
+	f, calls := flaky(2)
+	res := call(Func(f, WithClock(clk)), 21)
+
+	clk.BlockUntil(1)
+	clk.Advance(100 * time.Millisecond)
+	clk.BlockUntil(1)
+	clk.Advance(199 * time.Millisecond)
+	require.Empty(t, res)
+	clk.Advance(time.Millisecond)
+
+	require.Equal(t, result{42, nil}, <-res)
+	require.Equal(t, 3, *calls)
+}
+
+func TestFunc_Success(t *testing.T) {
+	f, calls := flaky(0)
+	res, err := Func(f)(1)
+	require.NoError(t, err)
+	require.Equal(t, 2, res)
+	require.Equal(t, 1, *calls)
+}
+
+func TestFunc_Exhausted(t *testing.T) {
+	f, calls := flaky(5)
+	res, err := Func(f, Attempts(3), Backoff(0, 0, 1))(1)
+	require.ErrorIs(t, err, errFlaky)
+	require.Zero(t, res)
+	require.Equal(t, 3, *calls)
+}
+
+func TestAttempts_Invalid(t *testing.T) {
+	for _, n := range []int{0, -1} {
+		require.Panics(t, func() {
+			Attempts(n)
+		})
+	}
+}
+
+func TestFunc_NotRetryable(t *testing.T) {
+	f, calls := flaky(5)
+	_, err := Func(f, If(func(err error) bool {
+		return !errors.Is(err, errFlaky)
+	}))(1)
+	require.ErrorIs(t, err, errFlaky)
+	require.Equal(t, 1, *calls)
+}
+
+func TestFunc_Timeout(t *testing.T) {
+	clk := clock.NewFake(time.Time{})
+	block := make(chan struct{})
+	defer close(block)
+	started := make(chan struct{})
+	var calls int32
+	f := func(v int) (int, error) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			close(started)
+			<-block
+		}
+		return v, nil
+	}
+	res := call(Func(f, Timeout(time.Second), Backoff(time.Second, time.Second, 1), WithClock(clk)), 1)
+
+	<-started
+	clk.BlockUntil(1)
+	clk.Advance(time.Second)
+	clk.BlockUntil(1)
+	clk.Advance(time.Second)
+	require.Equal(t, result{1, nil}, <-res)
+	require.Equal(t, int32(2), atomic.LoadInt32(&calls))
+}
+
+func TestFunc_TimeoutExhausted(t *testing.T) {
+	clk := clock.NewFake(time.Time{})
+	block := make(chan struct{})
+	defer close(block)
+	f := func(v int) (int, error) {
+		<-block
+		return v, nil
+	}
+	res := call(Func(f, Attempts(1), Timeout(time.Second), WithClock(clk)), 1)
+	clk.BlockUntil(1)
+	clk.Advance(time.Second)
+	r := <-res
+	require.ErrorIs(t, r.err, ErrTimeout)
+}
+
+func TestContext_Cancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	clk := clock.NewFake(time.Time{})
+	calls := 0
+	f := Context(func(ctx context.Context, v int) (int, error) {
+		calls++
+		return 0, errFlaky
+	}, WithClock(clk))
+
+	res := make(chan error, 1)
+	go func() {
+		_, err := f(ctx, 1)
+		res <- err
+	}()
+	clk.BlockUntil(1)
+	cancel()
+	require.ErrorIs(t, <-res, context.Canceled)
+	require.Equal(t, 1, calls)
+}
+
+func TestContext_TimeoutCancelsAttempt(t *testing.T) {
+	clk := clock.NewFake(time.Time{})
+	cancelled := make(chan error, 1)
+	f := Context(func(ctx context.Context, v int) (int, error) {
+		<-ctx.Done()
+		cancelled <- ctx.Err()
+		return 0, ctx.Err()
+	}, Attempts(1), Timeout(time.Second), WithClock(clk))
+
+	res := make(chan error, 1)
+	go func() {
+		_, err := f(context.Background(), 1)
+		res <- err
+	}()
+	clk.BlockUntil(1)
+	clk.Advance(time.Second)
+	require.ErrorIs(t, <-res, ErrTimeout)
+	require.ErrorIs(t, <-cancelled, context.Canceled)
+}
+
+func TestDelay(t *testing.T) {
+	testCases := []struct {
+		name     string
+		opts     []Option
+		expected []time.Duration
+	}{
+		{
+			"Default",
+			nil,
+			[]time.Duration{100 * time.Millisecond, 200 * time.Millisecond, 400 * time.Millisecond},
+		},
+		{
+			"Capped",
+			[]Option{Backoff(time.Second, 3*time.Second, 2)},
+			[]time.Duration{time.Second, 2 * time.Second, 3 * time.Second},
+		},
+		{
+			"Jitter high",
+			[]Option{Backoff(time.Second, time.Minute, 2), Jitter(0.5), WithRand(func() float64 { return 1 })},
+			[]time.Duration{1500 * time.Millisecond, 3 * time.Second, 6 * time.Second},
+		},
+		{
+			"Jitter low",
+			[]Option{Backoff(time.Second, time.Minute, 2), Jitter(0.5), WithRand(func() float64 { return 0 })},
+			[]time.Duration{500 * time.Millisecond, time.Second, 2 * time.Second},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := newConfig(tc.opts)
+			var res []time.Duration
+			for n := 0; n < len(tc.expected); n++ {
+				res = append(res, cfg.delay(n))
+			}
+			require.Equal(t, tc.expected, res)
+		})
+	}
+}
+
+func TestOrElse(t *testing.T) {
+	f := OrElse(func(v int) (int, error) {
+		if v < 0 {
+			return 0, errFlaky
+		}
+		return v * 2, nil
+	}, -1)
+	res := iterable.Map(iterable.New([]int{1, -1, 2}), f).ToSlice()
+	require.Equal(t, []int{2, -1, 4}, res)
+}
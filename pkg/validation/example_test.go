@@ -0,0 +1,58 @@
+package validation_test
+
+import (
+	"errors"
+	"fmt"
+	"github.com/sergeychunayev/gofu/pkg/iterable"
+	"github.com/sergeychunayev/gofu/pkg/validation"
+)
+
+type order struct {
+	id  string
+	qty int
+}
+
+func required(s string) error {
+	if s == "" {
+		return errors.New("required")
+	}
+	return nil
+}
+
+func positive(v int) error {
+	if v <= 0 {
+		return errors.New("must be positive")
+	}
+	return nil
+}
+
+func validateOrder(o order) validation.Validation[order] {
+	return validation.Combine2(
+		validation.Field("id", o.id, required),
+		validation.Field("qty", o.qty, positive),
+		func(id string, qty int) order {
+			return order{id, qty}
+		},
+	)
+}
+
+func ExampleCombine2() {
+	_, err := validateOrder(order{}).Get()
+	fmt.Println(err)
+	// Output:
+	// id: required
+	// qty: must be positive
+}
+
+func ExampleValidateAll() {
+	valid, report := validation.ValidateAll(
+		iterable.New([]order{{"a", 1}, {"", 2}, {"c", 0}}),
+		validateOrder,
+	)
+	fmt.Println(valid)
+	fmt.Println(report.Err())
+	// Output:
+	// [{a 1}]
+	// 1: id: required
+	// 2: qty: must be positive
+}
@@ -0,0 +1,61 @@
+package validation
+
+import (
+	"fmt"
+	"github.com/sergeychunayev/gofu/pkg/iterable"
+	"strings"
+)
+
+// ItemError holds the errors found for the element at Index.
+type ItemError struct {
+	Index  int
+	Errors []error
+}
+
+// Report lists the invalid elements of an Iterable in iteration order.
+type Report []ItemError
+
+// Error describes every invalid element, one per line.
+func (r Report) Error() string {
+	var sb strings.Builder
+	for i, item := range r {
+		if i > 0 {
+			sb.WriteByte('\n')
+		}
+		fmt.Fprintf(&sb, "%d:", item.Index)
+		for j, err := range item.Errors {
+			if j > 0 {
+				sb.WriteByte(';')
+			}
+			sb.WriteByte(' ')
+			sb.WriteString(err.Error())
+		}
+	}
+	return sb.String()
+}
+
+// Err returns r as an error, or nil if r is empty.
+func (r Report) Err() error {
+	if len(r) == 0 {
+		return nil
+	}
+	return r
+}
+
+// ValidateAll runs validate against every element of it and returns the
+// valid elements together with a Report of the invalid ones.
+func ValidateAll[T any](it iterable.Iterable[T], validate Validator[T]) ([]T, Report) {
+	var valid []T
+	var report Report
+	i := 0
+	for el, ok := it.TryNext(); ok; el, ok = it.TryNext() {
+		v := validate(el)
+		if len(v.errs) > 0 {
+			report = append(report, ItemError{Index: i, Errors: v.errs})
+		} else {
+			valid = append(valid, v.v)
+		}
+		i++
+	}
+	return valid, report
+}
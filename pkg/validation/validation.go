@@ -0,0 +1,170 @@
+package validation
+
+import (
+	"errors"
+	"fmt"
+	"github.com/sergeychunayev/gofu/pkg/option"
+)
+
+// Validation holds either a valid value or every error found while
+// validating it. Unlike result.Result, combining Validations keeps the errors
+// of all of them rather than stopping at the first. The zero value is Valid
+// with the zero value of T.
+type Validation[T any] struct {
+	v    T
+	errs []error
+}
+
+// Validator checks a value and returns every problem found.
+type Validator[T any] func(v T) Validation[T]
+
+func Valid[T any](v T) Validation[T] {
+	return Validation[T]{v: v}
+}
+
+// Invalid returns a failed Validation. Nil errors are dropped; if none are
+// left an unknown error is recorded so that the Validation is never valid.
+func Invalid[T any](errs ...error) Validation[T] {
+	res := Validation[T]{}
+	for _, err := range errs {
+		if err != nil {
+			res.errs = append(res.errs, err)
+		}
+	}
+	if len(res.errs) == 0 {
+		res.errs = []error{errUnknown}
+	}
+	return res
+}
+
+var errUnknown = errors.New("validation: nil error")
+
+func (v Validation[T]) IsValid() bool {
+	return len(v.errs) == 0
+}
+
+// Errors returns the errors of v, or nil if v is valid.
+func (v Validation[T]) Errors() []error {
+	return v.errs
+}
+
+// Get returns the value of v and its errors joined with errors.Join.
+func (v Validation[T]) Get() (T, error) {
+	return v.v, errors.Join(v.errs...)
+}
+
+func (v Validation[T]) String() string {
+	if len(v.errs) > 0 {
+		return fmt.Sprintf("Invalid(%v)", errors.Join(v.errs...))
+	}
+	return fmt.Sprintf("Valid(%v)", v.v)
+}
+
+// FieldError is an error found while validating a named field.
+type FieldError struct {
+	Field string
+	Err   error
+}
+
+func (e *FieldError) Error() string {
+	return fmt.Sprintf("%s: %v", e.Field, e.Err)
+}
+
+func (e *FieldError) Unwrap() error {
+	return e.Err
+}
+
+// Check runs every check against v and collects the errors they return.
+func Check[T any](v T, checks ...func(v T) error) Validation[T] {
+	res := Validation[T]{v: v}
+	for _, check := range checks {
+		if err := check(v); err != nil {
+			res.errs = append(res.errs, err)
+		}
+	}
+	return res
+}
+
+// Field runs every check against the value of a named field and wraps the
+// errors in FieldError.
+func Field[T any](name string, v T, checks ...func(v T) error) Validation[T] {
+	res := Check(v, checks...)
+	for i, err := range res.errs {
+		res.errs[i] = &FieldError{Field: name, Err: err}
+	}
+	return res
+}
+
+// All combines validators into one that runs each of them and collects all
+// their errors.
+func All[T any](validators ...Validator[T]) Validator[T] {
+	return func(v T) Validation[T] {
+		res := Validation[T]{v: v}
+		for _, validator := range validators {
+			res.errs = append(res.errs, validator(v).errs...)
+		}
+		return res
+	}
+}
+
+// Map applies f to the value of v if v is valid.
+func Map[T any, U any](v Validation[T], f func(v T) U) Validation[U] {
+	if len(v.errs) > 0 {
+		return Validation[U]{errs: v.errs}
+	}
+	return Valid(f(v.v))
+}
+
+// FlatMap applies f to the value of v if v is valid. Since f depends on the
+// value it cannot run on invalid input, so FlatMap stops at the first
+// failed step; use Combine2, Combine3 or Combine4 to collect errors from
+// independent steps.
+func FlatMap[T any, U any](v Validation[T], f func(v T) Validation[U]) Validation[U] {
+	if len(v.errs) > 0 {
+		return Validation[U]{errs: v.errs}
+	}
+	return f(v.v)
+}
+
+// Combine2 applies f to the values of a and b if both are valid and
+// otherwise returns the errors of both.
+func Combine2[A any, B any, R any](a Validation[A], b Validation[B], f func(a A, b B) R) Validation[R] {
+	if errs := join(a.errs, b.errs); len(errs) > 0 {
+		return Validation[R]{errs: errs}
+	}
+	return Valid(f(a.v, b.v))
+}
+
+// Combine3 applies f to the values of a, b and c if all are valid and
+// otherwise returns the errors of all of them.
+func Combine3[A any, B any, C any, R any](a Validation[A], b Validation[B], c Validation[C], f func(a A, b B, c C) R) Validation[R] {
+	if errs := join(a.errs, b.errs, c.errs); len(errs) > 0 {
+		return Validation[R]{errs: errs}
+	}
+	return Valid(f(a.v, b.v, c.v))
+}
+
+// Combine4 applies f to the values of a, b, c and d if all are valid and
+// otherwise returns the errors of all of them.
+func Combine4[A any, B any, C any, D any, R any](a Validation[A], b Validation[B], c Validation[C], d Validation[D], f func(a A, b B, c C, d D) R) Validation[R] {
+	if errs := join(a.errs, b.errs, c.errs, d.errs); len(errs) > 0 {
+		return Validation[R]{errs: errs}
+	}
+	return Valid(f(a.v, b.v, c.v, d.v))
+}
+
+// ToOption returns the value of v as Some if v is valid.
+func ToOption[T any](v Validation[T]) option.Option[T] {
+	if len(v.errs) > 0 {
+		return option.No[T]()
+	}
+	return option.Of(v.v)
+}
+
+func join(errs ...[]error) []error {
+	var res []error
+	for _, e := range errs {
+		res = append(res, e...)
+	}
+	return res
+}
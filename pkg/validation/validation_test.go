@@ -0,0 +1,175 @@
+package validation
+
+import (
+	"errors"
+	"github.com/sergeychunayev/gofu/pkg/iterable"
+	"github.com/sergeychunayev/gofu/pkg/option"
+	"github.com/stretchr/testify/require"
+	"strings"
+	"testing"
+)
+
+var (
+	errEmpty    = errors.New("empty")
+	errTooLong  = errors.New("too long")
+	errNegative = errors.New("negative")
+)
+
+func notEmpty(s string) error {
+	if s == "" {
+		return errEmpty
+	}
+	return nil
+}
+
+func maxLen(n int) func(s string) error {
+	return func(s string) error {
+		if len(s) > n {
+			return errTooLong
+		}
+		return nil
+	}
+}
+
+func positive(v int) error {
+	if v < 0 {
+		return errNegative
+	}
+	return nil
+}
+
+type user struct {
+	name string
+	age  int
+}
+
+func validateUser(u user) Validation[user] {
+	return Combine2(
+		Field("name", u.name, notEmpty, maxLen(3)),
+		Field("age", u.age, positive),
+		func(name string, age int) user {
+			return user{name, age}
+		},
+	)
+}
+
+func TestValidation(t *testing.T) {
+	v := Valid(1)
+	require.True(t, v.IsValid())
+	require.Nil(t, v.Errors())
+	res, err := v.Get()
+	require.NoError(t, err)
+	require.Equal(t, 1, res)
+	require.Equal(t, "Valid(1)", v.String())
+
+	inv := Invalid[int](errEmpty, nil, errNegative)
+	require.False(t, inv.IsValid())
+	require.Equal(t, []error{errEmpty, errNegative}, inv.Errors())
+	_, err = inv.Get()
+	require.ErrorIs(t, err, errEmpty)
+	require.ErrorIs(t, err, errNegative)
+	require.Equal(t, "Invalid(empty\nnegative)", inv.String())
+
+	require.False(t, Invalid[int]().IsValid())
+}
+
+func TestCheck(t *testing.T) {
+	require.True(t, Check("abc", notEmpty, maxLen(3)).IsValid())
+	require.Equal(t, []error{errEmpty}, Check("", notEmpty, maxLen(3)).Errors())
+	require.Equal(t, []error{errEmpty, errTooLong}, Check("", notEmpty, maxLen(-1)).Errors())
+}
+
+func TestField(t *testing.T) {
+	errs := Field("name", "", notEmpty).Errors()
+	require.Len(t, errs, 1)
+	require.EqualError(t, errs[0], "name: empty")
+	require.ErrorIs(t, errs[0], errEmpty)
+	var fieldErr *FieldError
+	require.ErrorAs(t, errs[0], &fieldErr)
+	require.Equal(t, "name", fieldErr.Field)
+}
+
+func TestCombine(t *testing.T) {
+	testCases := []struct {
+		name     string
+		input    user
+		expected []string
+	}{
+		{"Valid", user{"bob", 1}, nil},
+		{"One field", user{"", 1}, []string{"name: empty"}},
+		{"All fields", user{"robert", -1}, []string{"name: too long", "age: negative"}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			v := validateUser(tc.input)
+			var res []string
+			for _, err := range v.Errors() {
+				res = append(res, err.Error())
+			}
+			require.Equal(t, tc.expected, res)
+			if v.IsValid() {
+				require.Equal(t, option.Of(tc.input), ToOption(v))
+			} else {
+				require.Equal(t, option.No[user](), ToOption(v))
+			}
+		})
+	}
+}
+
+func TestCombine3(t *testing.T) {
+	v := Combine3(Invalid[int](errEmpty), Valid(1), Invalid[int](errNegative), func(a, b, c int) int {
+		return a + b + c
+	})
+	require.Equal(t, []error{errEmpty, errNegative}, v.Errors())
+
+	v = Combine4(Valid(1), Valid(2), Valid(3), Valid(4), func(a, b, c, d int) int {
+		return a + b + c + d
+	})
+	require.Equal(t, Valid(10), v)
+}
+
+func TestAll(t *testing.T) {
+	validate := All(
+		func(s string) Validation[string] {
+			return Check(s, notEmpty)
+		},
+		func(s string) Validation[string] {
+			return Check(s, maxLen(0))
+		},
+	)
+	require.Equal(t, []error{errTooLong}, validate("a").Errors())
+	require.False(t, validate("").IsValid())
+	require.Equal(t, []error{errEmpty}, validate("").Errors())
+}
+
+func TestMap(t *testing.T) {
+	require.Equal(t, Valid("A"), Map(Valid("a"), strings.ToUpper))
+	require.Equal(t, []error{errEmpty}, Map(Invalid[string](errEmpty), strings.ToUpper).Errors())
+}
+
+func TestFlatMap(t *testing.T) {
+	f := func(s string) Validation[int] {
+		return Check(len(s), positive)
+	}
+	require.Equal(t, Valid(2), FlatMap(Valid("ab"), f))
+	require.Equal(t, []error{errEmpty}, FlatMap(Invalid[string](errEmpty), f).Errors())
+}
+
+func TestValidateAll(t *testing.T) {
+	input := []user{{"bob", 1}, {"", -1}, {"amy", 2}, {"robert", 3}}
+	valid, report := ValidateAll(iterable.New(input), validateUser)
+	require.Equal(t, []user{{"bob", 1}, {"amy", 2}}, valid)
+	require.Len(t, report, 2)
+	require.Equal(t, 1, report[0].Index)
+	require.Len(t, report[0].Errors, 2)
+	require.Equal(t, 3, report[1].Index)
+	require.EqualError(t, report.Err(), "1: name: empty; age: negative\n3: name: too long")
+	require.ErrorIs(t, report[1].Errors[0], errTooLong)
+}
+
+func TestValidateAll_Valid(t *testing.T) {
+	valid, report := ValidateAll(iterable.New([]user{{"bob", 1}}), validateUser)
+	require.Equal(t, []user{{"bob", 1}}, valid)
+	require.Empty(t, report)
+	require.NoError(t, report.Err())
+}